@@ -0,0 +1,138 @@
+package aider
+
+import (
+	"fmt"
+	"time"
+)
+
+// BucketSize ขนาดช่วงเวลาที่ใช้จัดกลุ่มข้อมูลใน BucketBy
+type BucketSize int
+
+const (
+	BucketHour          BucketSize = iota // รายชั่วโมง
+	BucketDay                             // รายวัน
+	BucketWeek                            // รายสัปดาห์แบบ ISO (เริ่มวันจันทร์)
+	BucketMonth                           // รายเดือน
+	BucketFiscalQuarter                   // รายไตรมาสของปีงบประมาณ (ต.ค.-ธ.ค. = ไตรมาส 1)
+)
+
+// Bucket ผลการจัดกลุ่มข้อมูลหนึ่งช่วงเวลา Range เป็นช่วงแบบครึ่งเปิด [Start, End)
+type Bucket[T any] struct {
+	Range TimeRange
+	Items []T
+	Count int
+	Sum   float64
+}
+
+// Avg ค่าเฉลี่ยของข้อมูลในช่วง (คืน 0 ถ้าไม่มีข้อมูล)
+func (b Bucket[T]) Avg() float64 {
+	if b.Count == 0 {
+		return 0
+	}
+	return b.Sum / float64(b.Count)
+}
+
+// Start เวลาเริ่มของช่วงที่ t อยู่ ตามโซนเวลาของแพ็กเกจ (ค่าเริ่มต้น Asia/Bangkok)
+func (s BucketSize) Start(t time.Time) time.Time {
+	t = t.In(loadLocation())
+	switch s {
+	case BucketHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case BucketDay:
+		return StartOfDay(t)
+	case BucketWeek:
+		return StartOfWeek(t, time.Monday)
+	case BucketMonth:
+		return StartOfMonth(t)
+	case BucketFiscalQuarter:
+		return FiscalQuarterRange(FiscalYear(t), FiscalQuarter(t)).Start
+	}
+	return t
+}
+
+// เวลาเริ่มของช่วงถัดไปจาก start ที่เป็นเวลาเริ่มของช่วงอยู่แล้ว
+func (s BucketSize) next(start time.Time) time.Time {
+	switch s {
+	case BucketHour:
+		return start.Add(time.Hour)
+	case BucketDay:
+		return start.AddDate(0, 0, 1)
+	case BucketWeek:
+		return start.AddDate(0, 0, 7)
+	case BucketMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 3, 0)
+	}
+}
+
+func (s BucketSize) valid() bool {
+	return s >= BucketHour && s <= BucketFiscalQuarter
+}
+
+// BucketBy จัดกลุ่ม items ตามช่วงเวลาขนาด size โดยใช้ timeFunc ดึงเวลาของแต่ละรายการ
+// และรวมค่าจาก valueFunc เป็น Count / Sum / Avg ต่อช่วง (valueFunc เป็น nil ได้ถ้าต้องการแค่จำนวน)
+// ช่วงที่ไม่มีข้อมูลในระหว่าง r จะถูกเติมเป็นช่วงว่าง รายการที่อยู่นอก r จะไม่ถูกนับ
+// ถ้า r เป็นค่าว่าง (TimeRange{}) จะใช้ช่วงตั้งแต่รายการแรกถึงรายการสุดท้าย
+func BucketBy[T any](items []T, size BucketSize, timeFunc func(T) time.Time, valueFunc func(T) float64, r TimeRange) ([]Bucket[T], error) {
+	if !size.valid() {
+		return nil, fmt.Errorf("invalid bucket size %d", size)
+	}
+	if r.Start.IsZero() && r.End.IsZero() {
+		if len(items) == 0 {
+			return nil, nil
+		}
+		for i, item := range items {
+			t := timeFunc(item)
+			if i == 0 || t.Before(r.Start) {
+				r.Start = t
+			}
+			if i == 0 || t.After(r.End) {
+				r.End = t
+			}
+		}
+		r.Closed = true
+	}
+	if r.IsEmpty() {
+		return nil, nil
+	}
+
+	var buckets []Bucket[T]
+	index := make(map[int64]int)
+	for start := size.Start(r.Start); start.Before(r.End) || (r.Closed && start.Equal(r.End)); start = size.next(start) {
+		index[start.Unix()] = len(buckets)
+		buckets = append(buckets, Bucket[T]{Range: NewTimeRange(start, size.next(start))})
+	}
+
+	for _, item := range items {
+		t := timeFunc(item)
+		if !r.Contains(t) {
+			continue
+		}
+		b := &buckets[index[size.Start(t).Unix()]]
+		b.Items = append(b.Items, item)
+		b.Count++
+		if valueFunc != nil {
+			b.Sum += valueFunc(item)
+		}
+	}
+	return buckets, nil
+
+	/*
+		Ex.
+		orders := []Order{
+			{CreatedAt: time.Date(2025, 1, 1, 9, 15, 0, 0, loadLocation()), Amount: 100},
+			{CreatedAt: time.Date(2025, 1, 1, 14, 0, 0, 0, loadLocation()), Amount: 300},
+			{CreatedAt: time.Date(2025, 1, 3, 10, 0, 0, 0, loadLocation()), Amount: 50},
+		}
+		r := NewTimeRange(time.Date(2025, 1, 1, 0, 0, 0, 0, loadLocation()), time.Date(2025, 1, 4, 0, 0, 0, 0, loadLocation()))
+		buckets, _ := BucketBy(orders, BucketDay, func(o Order) time.Time {
+			return o.CreatedAt
+		}, func(o Order) float64 {
+			return o.Amount
+		}, r)
+		// 2025-01-01 Count 2 Sum 400 Avg 200
+		// 2025-01-02 Count 0 Sum 0   Avg 0
+		// 2025-01-03 Count 1 Sum 50  Avg 50
+	*/
+}
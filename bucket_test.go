@@ -0,0 +1,142 @@
+package aider
+
+import (
+	"testing"
+	"time"
+)
+
+type bucketEvent struct {
+	At     time.Time
+	Amount float64
+}
+
+func TestBucketBy(t *testing.T) {
+	loc := loadLocation()
+	events := []bucketEvent{
+		{At: time.Date(2025, 1, 1, 9, 15, 0, 0, loc), Amount: 100},
+		{At: time.Date(2025, 1, 1, 14, 0, 0, 0, loc), Amount: 300},
+		// 2025-01-03 03:00 UTC คือ 10:00 ตามเวลาไทย
+		{At: time.Date(2025, 1, 3, 3, 0, 0, 0, time.UTC), Amount: 50},
+		{At: time.Date(2025, 3, 31, 23, 0, 0, 0, loc), Amount: 10},
+	}
+	eventTime := func(e bucketEvent) time.Time { return e.At }
+	eventAmount := func(e bucketEvent) float64 { return e.Amount }
+
+	tests := []struct {
+		name       string
+		size       BucketSize
+		r          TimeRange
+		wantStarts []time.Time
+		wantCounts []int
+		wantSums   []float64
+	}{
+		{
+			name: "รายวัน เติมวันที่ไม่มีข้อมูล",
+			size: BucketDay,
+			r:    NewTimeRange(time.Date(2025, 1, 1, 0, 0, 0, 0, loc), time.Date(2025, 1, 4, 0, 0, 0, 0, loc)),
+			wantStarts: []time.Time{
+				time.Date(2025, 1, 1, 0, 0, 0, 0, loc),
+				time.Date(2025, 1, 2, 0, 0, 0, 0, loc),
+				time.Date(2025, 1, 3, 0, 0, 0, 0, loc),
+			},
+			wantCounts: []int{2, 0, 1},
+			wantSums:   []float64{400, 0, 50},
+		},
+		{
+			name: "รายชั่วโมง ไม่นับรายการนอกช่วง",
+			size: BucketHour,
+			r:    NewTimeRange(time.Date(2025, 1, 1, 9, 30, 0, 0, loc), time.Date(2025, 1, 1, 12, 0, 0, 0, loc)),
+			wantStarts: []time.Time{
+				time.Date(2025, 1, 1, 9, 0, 0, 0, loc),
+				time.Date(2025, 1, 1, 10, 0, 0, 0, loc),
+				time.Date(2025, 1, 1, 11, 0, 0, 0, loc),
+			},
+			wantCounts: []int{0, 0, 0},
+			wantSums:   []float64{0, 0, 0},
+		},
+		{
+			name: "รายสัปดาห์ ISO เริ่มวันจันทร์",
+			size: BucketWeek,
+			r:    NewTimeRange(time.Date(2025, 1, 1, 0, 0, 0, 0, loc), time.Date(2025, 1, 13, 0, 0, 0, 0, loc)),
+			wantStarts: []time.Time{
+				time.Date(2024, 12, 30, 0, 0, 0, 0, loc),
+				time.Date(2025, 1, 6, 0, 0, 0, 0, loc),
+			},
+			wantCounts: []int{3, 0},
+			wantSums:   []float64{450, 0},
+		},
+		{
+			name: "รายเดือน ใช้ช่วงจากข้อมูลเมื่อไม่ระบุช่วง",
+			size: BucketMonth,
+			wantStarts: []time.Time{
+				time.Date(2025, 1, 1, 0, 0, 0, 0, loc),
+				time.Date(2025, 2, 1, 0, 0, 0, 0, loc),
+				time.Date(2025, 3, 1, 0, 0, 0, 0, loc),
+			},
+			wantCounts: []int{3, 0, 1},
+			wantSums:   []float64{450, 0, 10},
+		},
+		{
+			name: "รายไตรมาสปีงบประมาณ",
+			size: BucketFiscalQuarter,
+			r:    FiscalYearRange(2568),
+			wantStarts: []time.Time{
+				time.Date(2024, 10, 1, 0, 0, 0, 0, loc),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, loc),
+				time.Date(2025, 4, 1, 0, 0, 0, 0, loc),
+				time.Date(2025, 7, 1, 0, 0, 0, 0, loc),
+			},
+			wantCounts: []int{0, 4, 0, 0},
+			wantSums:   []float64{0, 460, 0, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buckets, err := BucketBy(events, tt.size, eventTime, eventAmount, tt.r)
+			if err != nil {
+				t.Fatalf("BucketBy() error = %v", err)
+			}
+			if len(buckets) != len(tt.wantStarts) {
+				t.Fatalf("BucketBy() returned %d buckets, want %d", len(buckets), len(tt.wantStarts))
+			}
+			for i, b := range buckets {
+				if !b.Range.Start.Equal(tt.wantStarts[i]) {
+					t.Errorf("bucket %d start = %v, want %v", i, b.Range.Start, tt.wantStarts[i])
+				}
+				if b.Count != tt.wantCounts[i] || len(b.Items) != tt.wantCounts[i] {
+					t.Errorf("bucket %d count = %d (%d items), want %d", i, b.Count, len(b.Items), tt.wantCounts[i])
+				}
+				if b.Sum != tt.wantSums[i] {
+					t.Errorf("bucket %d sum = %v, want %v", i, b.Sum, tt.wantSums[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBucketAvg(t *testing.T) {
+	loc := loadLocation()
+	events := []bucketEvent{
+		{At: time.Date(2025, 1, 1, 9, 0, 0, 0, loc), Amount: 100},
+		{At: time.Date(2025, 1, 1, 10, 0, 0, 0, loc), Amount: 300},
+	}
+	buckets, err := BucketBy(events, BucketDay, func(e bucketEvent) time.Time { return e.At }, nil, TimeRange{})
+	if err != nil {
+		t.Fatalf("BucketBy() error = %v", err)
+	}
+	if len(buckets) != 1 || buckets[0].Count != 2 || buckets[0].Avg() != 0 {
+		t.Errorf("BucketBy() without valueFunc = %+v, want 1 bucket with count 2 and avg 0", buckets)
+	}
+
+	buckets, _ = BucketBy(events, BucketDay, func(e bucketEvent) time.Time { return e.At }, func(e bucketEvent) float64 { return e.Amount }, TimeRange{})
+	if got := buckets[0].Avg(); got != 200 {
+		t.Errorf("Avg() = %v, want 200", got)
+	}
+	if got := (Bucket[bucketEvent]{}).Avg(); got != 0 {
+		t.Errorf("empty Avg() = %v, want 0", got)
+	}
+
+	if _, err := BucketBy(events, BucketSize(99), func(e bucketEvent) time.Time { return e.At }, nil, TimeRange{}); err == nil {
+		t.Error("BucketBy() with invalid size should return error")
+	}
+}
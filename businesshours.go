@@ -0,0 +1,220 @@
+package aider
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// WorkingPeriod ช่วงเวลาทำงานภายในวัน นับเป็นระยะเวลาจากเที่ยงคืน เช่น 08:30 = 8*time.Hour + 30*time.Minute
+type WorkingPeriod struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// จำนวนวันติดต่อกันที่ไม่มีเวลาทำงานได้มากที่สุดก่อนที่ Deadline จะหยุดค้นหา
+const maxIdleDays = 366
+
+// BusinessHours เวลาทำการของแต่ละวันในสัปดาห์ (พักกลางวันได้ด้วยการแบ่งเป็นหลายช่วง) และปฏิทินวันหยุด
+// ใช้คำนวณกำหนดเวลา SLA ตามชั่วโมงทำงาน การคำนวณทำในโซนเวลาของแพ็กเกจ (ค่าเริ่มต้น Asia/Bangkok)
+// ควรตั้งค่าเวลาทำการให้เสร็จก่อนใช้งานพร้อมกันหลาย goroutine
+type BusinessHours struct {
+	week     [7][]WorkingPeriod
+	calendar *HolidayCalendar
+}
+
+// NewBusinessHours สร้างเวลาทำการเปล่า (ยังไม่มีวันทำงาน) กำหนดแต่ละวันด้วย SetDay
+// cal เป็นปฏิทินวันหยุด (nil = ไม่มีวันหยุดนอกจากวันที่ไม่ได้กำหนดเวลาทำการ)
+func NewBusinessHours(cal *HolidayCalendar) *BusinessHours {
+	return &BusinessHours{calendar: cal}
+}
+
+// DefaultBusinessHours เวลาทำการจันทร์-ศุกร์ 08:30-12:00 และ 13:00-17:30 (วันละ 8 ชั่วโมง)
+func DefaultBusinessHours(cal *HolidayCalendar) *BusinessHours {
+	b := NewBusinessHours(cal)
+	periods := []WorkingPeriod{
+		{Start: 8*time.Hour + 30*time.Minute, End: 12 * time.Hour},
+		{Start: 13 * time.Hour, End: 17*time.Hour + 30*time.Minute},
+	}
+	for wd := time.Monday; wd <= time.Friday; wd++ {
+		_ = b.SetDay(wd, periods...)
+	}
+	return b
+}
+
+// SetDay กำหนดช่วงเวลาทำงานของวัน wd (ไม่ส่งช่วงเวลา = ไม่ทำงานวันนั้น)
+// ช่วงเวลาต้องอยู่ภายใน 00:00-24:00 และไม่ซ้อนทับกัน
+func (b *BusinessHours) SetDay(wd time.Weekday, periods ...WorkingPeriod) error {
+	if wd < time.Sunday || wd > time.Saturday {
+		return fmt.Errorf("invalid weekday %d", wd)
+	}
+	sorted := append([]WorkingPeriod(nil), periods...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+	for i, p := range sorted {
+		if p.Start < 0 || p.End > 24*time.Hour || p.Start >= p.End {
+			return fmt.Errorf("invalid working period %s-%s", formatClock(p.Start), formatClock(p.End))
+		}
+		if i > 0 && p.Start < sorted[i-1].End {
+			return fmt.Errorf("overlapping working periods at %s", formatClock(p.Start))
+		}
+	}
+	b.week[wd] = sorted
+	return nil
+
+	/*
+		Ex.
+		b := NewBusinessHours(NewThaiHolidayCalendar())
+		periods, _ := ParseWorkingPeriods("08:30-12:00,13:00-17:30")
+		for wd := time.Monday; wd <= time.Friday; wd++ {
+			b.SetDay(wd, periods...)
+		}
+		b.SetDay(time.Saturday, WorkingPeriod{Start: 9 * time.Hour, End: 12 * time.Hour})
+	*/
+}
+
+// ParseWorkingPeriods แปลงข้อความช่วงเวลาทำงาน เช่น "08:30-12:00,13:00-17:30"
+func ParseWorkingPeriods(s string) ([]WorkingPeriod, error) {
+	var periods []WorkingPeriod
+	for _, part := range strings.Split(s, ",") {
+		startStr, endStr, ok := strings.Cut(strings.TrimSpace(part), "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid working period %q", part)
+		}
+		start, err := parseClock(startStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid working period %q: %w", part, err)
+		}
+		end, err := parseClock(endStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid working period %q: %w", part, err)
+		}
+		periods = append(periods, WorkingPeriod{Start: start, End: end})
+	}
+	return periods, nil
+}
+
+// IsWorkingTime ตรวจสอบว่า t อยู่ในเวลาทำการหรือไม่
+func (b *BusinessHours) IsWorkingTime(t time.Time) bool {
+	t = t.In(loadLocation())
+	for _, p := range b.periodsOn(CivilDateOf(t)) {
+		if !t.Before(p.Start) && t.Before(p.End) {
+			return true
+		}
+	}
+	return false
+}
+
+// Deadline คืนเวลาที่ครบ d ชั่วโมงทำงานนับจาก start โดยข้ามเวลานอกเวลาทำการ วันหยุด และเวลาพัก
+// ถ้าครบพอดีตอนสิ้นสุดช่วงเวลาทำงาน จะคืนเวลาสิ้นสุดช่วงนั้น (เช่น 17:30) ไม่ใช่เวลาเริ่มงานวันถัดไป
+// คืน error ถ้าไม่พบเวลาทำงานเลยติดต่อกันเกิน 1 ปี (เช่น ปฏิทินวันหยุดครอบคลุมทุกวันทำงาน)
+func (b *BusinessHours) Deadline(start time.Time, d time.Duration) (time.Time, error) {
+	if !b.hasWorkingDays() {
+		return time.Time{}, errors.New("business hours: no working periods")
+	}
+	cur := start.In(loadLocation())
+	if d <= 0 {
+		return cur, nil
+	}
+
+	remaining := d
+	idleDays := 0
+	for day := CivilDateOf(cur); ; day = day.AddDays(1) {
+		periods := b.periodsOn(day)
+		if len(periods) == 0 {
+			idleDays++
+			if idleDays > maxIdleDays {
+				return time.Time{}, fmt.Errorf("business hours: no working time for %d consecutive days", maxIdleDays)
+			}
+			continue
+		}
+		idleDays = 0
+		for _, p := range periods {
+			if !p.End.After(cur) {
+				continue
+			}
+			if p.Start.After(cur) {
+				cur = p.Start
+			}
+			avail := p.End.Sub(cur)
+			if remaining <= avail {
+				return cur.Add(remaining), nil
+			}
+			remaining -= avail
+			cur = p.End
+		}
+	}
+
+	/*
+		Ex.
+		b := DefaultBusinessHours(NewThaiHolidayCalendar())
+		start := time.Date(2025, 4, 11, 15, 0, 0, 0, loadLocation()) // ศุกร์ก่อนสงกรานต์
+		b.Deadline(start, 8*time.Hour) // 2025-04-17 15:00:00 +0700 (ข้ามเสาร์-อาทิตย์ สงกรานต์ และวันหยุดชดเชย)
+	*/
+}
+
+// WorkingTime คืนระยะเวลาทำงานระหว่าง from ถึง to (คืน 0 ถ้า to ไม่อยู่หลัง from)
+func (b *BusinessHours) WorkingTime(from, to time.Time) time.Duration {
+	loc := loadLocation()
+	from, to = from.In(loc), to.In(loc)
+	var total time.Duration
+	for day := CivilDateOf(from); !day.After(CivilDateOf(to)); day = day.AddDays(1) {
+		for _, p := range b.periodsOn(day) {
+			start, end := p.Start, p.End
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+	}
+	return total
+}
+
+// ช่วงเวลาทำงานของวันที่ d เป็นเวลาจริง (วันหยุดจะไม่มีช่วงเวลาทำงาน)
+func (b *BusinessHours) periodsOn(d CivilDate) []TimeRange {
+	periods := b.week[d.Weekday()]
+	if len(periods) == 0 {
+		return nil
+	}
+	midnight := d.In(loadLocation())
+	if b.calendar != nil && b.calendar.IsHoliday(midnight) {
+		return nil
+	}
+	ranges := make([]TimeRange, len(periods))
+	for i, p := range periods {
+		ranges[i] = NewTimeRange(midnight.Add(p.Start), midnight.Add(p.End))
+	}
+	return ranges
+}
+
+func (b *BusinessHours) hasWorkingDays() bool {
+	for _, periods := range b.week {
+		if len(periods) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// แปลงเวลา "15:04" เป็นระยะเวลาจากเที่ยงคืน (รองรับ "24:00")
+func parseClock(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func formatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+}
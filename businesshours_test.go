@@ -0,0 +1,91 @@
+package aider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessHoursDeadline(t *testing.T) {
+	loc := loadLocation()
+	b := DefaultBusinessHours(NewThaiHolidayCalendar())
+	tests := []struct {
+		name  string
+		start time.Time
+		d     time.Duration
+		want  time.Time
+	}{
+		{name: "ภายในวันเดียวข้ามพักกลางวัน", start: time.Date(2025, 3, 3, 10, 0, 0, 0, loc), d: 4 * time.Hour, want: time.Date(2025, 3, 3, 15, 0, 0, 0, loc)},
+		{name: "เริ่มก่อนเวลาทำการ", start: time.Date(2025, 3, 3, 6, 0, 0, 0, loc), d: 8 * time.Hour, want: time.Date(2025, 3, 3, 17, 30, 0, 0, loc)},
+		{name: "เริ่มช่วงพักกลางวัน", start: time.Date(2025, 3, 3, 12, 15, 0, 0, loc), d: 30 * time.Minute, want: time.Date(2025, 3, 3, 13, 30, 0, 0, loc)},
+		{name: "ข้ามคืนและวันหยุดสุดสัปดาห์", start: time.Date(2025, 3, 7, 16, 0, 0, 0, loc), d: 2 * time.Hour, want: time.Date(2025, 3, 10, 9, 0, 0, 0, loc)},
+		{name: "ข้ามวันหยุดสงกรานต์", start: time.Date(2025, 4, 11, 15, 0, 0, 0, loc), d: 8 * time.Hour, want: time.Date(2025, 4, 17, 15, 0, 0, 0, loc)},
+		{name: "เวลา UTC", start: time.Date(2025, 3, 3, 3, 0, 0, 0, time.UTC), d: time.Hour, want: time.Date(2025, 3, 3, 11, 0, 0, 0, loc)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Deadline(tt.start, tt.d)
+			if err != nil {
+				t.Fatalf("Deadline() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Deadline() = %v, want %v", got, tt.want)
+			}
+			if elapsed := b.WorkingTime(tt.start, got); elapsed != tt.d {
+				t.Errorf("WorkingTime() = %v, want %v", elapsed, tt.d)
+			}
+		})
+	}
+
+	if _, err := NewBusinessHours(nil).Deadline(time.Now(), time.Hour); err == nil {
+		t.Errorf("Deadline() expected error without working periods")
+	}
+}
+
+func TestBusinessHoursConfig(t *testing.T) {
+	periods, err := ParseWorkingPeriods("09:00-12:00, 13:00-18:00")
+	if err != nil {
+		t.Fatalf("ParseWorkingPeriods() error = %v", err)
+	}
+	b := NewBusinessHours(nil)
+	if err := b.SetDay(time.Saturday, periods...); err != nil {
+		t.Fatalf("SetDay() error = %v", err)
+	}
+	loc := loadLocation()
+	if !b.IsWorkingTime(time.Date(2025, 3, 8, 17, 59, 0, 0, loc)) || b.IsWorkingTime(time.Date(2025, 3, 8, 12, 30, 0, 0, loc)) {
+		t.Errorf("IsWorkingTime() returned unexpected result")
+	}
+	if got := b.WorkingTime(time.Date(2025, 3, 1, 0, 0, 0, 0, loc), time.Date(2025, 3, 15, 0, 0, 0, 0, loc)); got != 16*time.Hour {
+		t.Errorf("WorkingTime() = %v, want 16h", got)
+	}
+
+	if err := b.SetDay(time.Monday, WorkingPeriod{Start: 9 * time.Hour, End: 12 * time.Hour}, WorkingPeriod{Start: 11 * time.Hour, End: 13 * time.Hour}); err == nil {
+		t.Errorf("SetDay() expected error for overlapping periods")
+	}
+	if _, err := ParseWorkingPeriods("09:00-25:00"); err == nil {
+		t.Errorf("ParseWorkingPeriods() expected error")
+	}
+	if err := b.SetDay(7, periods...); err == nil {
+		t.Errorf("SetDay() expected error for weekday 7")
+	}
+	if err := b.SetDay(-1, periods...); err == nil {
+		t.Errorf("SetDay() expected error for weekday -1")
+	}
+}
+
+func TestBusinessHoursDeadlineNoWorkingTime(t *testing.T) {
+	loc := loadLocation()
+	cal := NewHolidayCalendar()
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, loc)
+	for d := start; d.Year() < 2028; d = d.AddDate(0, 0, 1) {
+		if d.Weekday() == time.Saturday {
+			cal.Add(d, "ปิดทุกวันเสาร์")
+		}
+	}
+	b := NewBusinessHours(cal)
+	if err := b.SetDay(time.Saturday, WorkingPeriod{Start: 9 * time.Hour, End: 12 * time.Hour}); err != nil {
+		t.Fatalf("SetDay() error = %v", err)
+	}
+	if _, err := b.Deadline(start, time.Hour); err == nil {
+		t.Error("Deadline() expected error when the calendar blocks every working day")
+	}
+}
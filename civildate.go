@@ -0,0 +1,232 @@
+package aider
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CivilDate วันที่แบบไม่มีเวลาและโซนเวลา (ปี/เดือน/วัน) สำหรับข้อมูลที่เป็นวันที่ล้วน เช่น วันเกิด วันครบกำหนด
+// ค่าว่าง (IsZero) จะแสดงเป็น "0000-00-00" และบันทึกลง JSON/ฐานข้อมูลเป็น null
+type CivilDate struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewCivilDate สร้าง CivilDate โดยปรับค่าที่เกินให้ถูกต้องแบบเดียวกับ time.Date (เช่น 32 ม.ค. -> 1 ก.พ.)
+func NewCivilDate(year int, month time.Month, day int) CivilDate {
+	return CivilDateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// CivilDateOf ดึงวันที่จาก t ตามโซนเวลาของ t เอง
+func CivilDateOf(t time.Time) CivilDate {
+	y, m, d := t.Date()
+	return CivilDate{Year: y, Month: m, Day: d}
+}
+
+// CivilToday วันที่ปัจจุบันตามโซนเวลาของแพ็กเกจ
+func CivilToday() CivilDate {
+	return CivilDateOf(now().In(loadLocation()))
+}
+
+// ParseCivilDate แปลงข้อความ "2006-01-02" เป็น CivilDate ("0000-00-00" และข้อความว่างจะได้ค่าว่าง)
+func ParseCivilDate(s string) (CivilDate, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == mysqlZeroDate {
+		return CivilDate{}, nil
+	}
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return CivilDate{}, fmt.Errorf("invalid date %q", s)
+	}
+	return CivilDateOf(t), nil
+}
+
+// String คืนวันที่ในรูปแบบ "2006-01-02"
+func (d CivilDate) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// IsZero ตรวจสอบว่าเป็นค่าว่างหรือไม่
+func (d CivilDate) IsZero() bool {
+	return d == CivilDate{}
+}
+
+// IsValid ตรวจสอบว่าเป็นวันที่ที่มีอยู่จริงหรือไม่ (เช่น 30 ก.พ. ไม่ถูกต้อง)
+func (d CivilDate) IsValid() bool {
+	return NewCivilDate(d.Year, d.Month, d.Day) == d
+}
+
+// In แปลงเป็น time.Time เวลา 00:00:00 ตามโซนเวลาที่กำหนด
+func (d CivilDate) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// Time แปลงเป็น time.Time เวลา 00:00:00 ตามโซนเวลาของแพ็กเกจ
+func (d CivilDate) Time() time.Time {
+	return d.In(loadLocation())
+}
+
+// Weekday คืนวันในสัปดาห์
+func (d CivilDate) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// AddDays บวก (หรือลบ) จำนวนวัน
+func (d CivilDate) AddDays(n int) CivilDate {
+	return NewCivilDate(d.Year, d.Month, d.Day+n)
+}
+
+// AddMonths บวก (หรือลบ) จำนวนเดือน ถ้าวันที่เกินวันสุดท้ายของเดือนปลายทางจะปัดเป็นวันสุดท้ายของเดือน
+// เช่น 31 ม.ค. + 1 เดือน = 28 (หรือ 29) ก.พ.
+func (d CivilDate) AddMonths(n int) CivilDate {
+	first := NewCivilDate(d.Year, d.Month+time.Month(n), 1)
+	day := d.Day
+	if last := daysInMonth(first.Year, first.Month); day > last {
+		day = last
+	}
+	return CivilDate{Year: first.Year, Month: first.Month, Day: day}
+}
+
+// AddYears บวก (หรือลบ) จำนวนปี โดย 29 ก.พ. ในปีที่ไม่ใช่ปีอธิกสุรทินจะปัดเป็น 28 ก.พ.
+func (d CivilDate) AddYears(n int) CivilDate {
+	return d.AddMonths(n * 12)
+}
+
+// DaysSince จำนวนวันจาก o ถึง d (ติดลบถ้า d อยู่ก่อน o)
+func (d CivilDate) DaysSince(o CivilDate) int {
+	return int((d.In(time.UTC).Unix() - o.In(time.UTC).Unix()) / 86400)
+}
+
+// Compare เปรียบเทียบวันที่ คืนค่า -1 ถ้า d อยู่ก่อน o, 0 ถ้าเท่ากัน และ 1 ถ้า d อยู่หลัง o
+func (d CivilDate) Compare(o CivilDate) int {
+	switch {
+	case d.Year != o.Year:
+		return compareInt(d.Year, o.Year)
+	case d.Month != o.Month:
+		return compareInt(int(d.Month), int(o.Month))
+	default:
+		return compareInt(d.Day, o.Day)
+	}
+}
+
+// Before ตรวจสอบว่า d อยู่ก่อน o หรือไม่
+func (d CivilDate) Before(o CivilDate) bool {
+	return d.Compare(o) < 0
+}
+
+// After ตรวจสอบว่า d อยู่หลัง o หรือไม่
+func (d CivilDate) After(o CivilDate) bool {
+	return d.Compare(o) > 0
+}
+
+// Equal ตรวจสอบว่าเป็นวันเดียวกันหรือไม่
+func (d CivilDate) Equal(o CivilDate) bool {
+	return d == o
+}
+
+// EachCivilDate วนทีละวันตั้งแต่ start ถึง end (รวมวันสุดท้าย) หยุดเมื่อ fn คืนค่า false
+func EachCivilDate(start, end CivilDate, fn func(CivilDate) bool) {
+	for d := start; !d.After(end); d = d.AddDays(1) {
+		if !fn(d) {
+			return
+		}
+	}
+}
+
+// CivilDatesBetween คืนรายการวันที่ตั้งแต่ start ถึง end (รวมวันสุดท้าย)
+func CivilDatesBetween(start, end CivilDate) []CivilDate {
+	var dates []CivilDate
+	EachCivilDate(start, end, func(d CivilDate) bool {
+		dates = append(dates, d)
+		return true
+	})
+	return dates
+}
+
+// MarshalText แปลงเป็นข้อความ "2006-01-02"
+func (d CivilDate) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText อ่านค่าจากข้อความ "2006-01-02"
+func (d *CivilDate) UnmarshalText(b []byte) error {
+	v, err := ParseCivilDate(string(b))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalJSON แปลงเป็น JSON "2006-01-02" ค่าว่างจะเป็น null
+func (d CivilDate) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON อ่านค่าจาก JSON (null, "" และ "0000-00-00" จะได้ค่าว่าง)
+func (d *CivilDate) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*d = CivilDate{}
+		return nil
+	}
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return fmt.Errorf("invalid date %s", b)
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// Value ใช้บันทึกลงฐานข้อมูล (driver.Valuer) ค่าว่างจะบันทึกเป็น NULL
+func (d CivilDate) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.String(), nil
+}
+
+// Scan ใช้อ่านค่าจากฐานข้อมูล (sql.Scanner) รองรับ NULL, time.Time, string และ []byte
+func (d *CivilDate) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = CivilDate{}
+		return nil
+	case time.Time:
+		*d = CivilDateOf(v)
+		return nil
+	case string:
+		return d.UnmarshalText([]byte(datePart(v)))
+	case []byte:
+		return d.UnmarshalText([]byte(datePart(string(v))))
+	}
+	return fmt.Errorf("cannot scan %T into CivilDate", src)
+}
+
+// ตัดเฉพาะส่วนวันที่จาก "2006-01-02 15:04:05" หรือ "2006-01-02T15:04:05Z"
+func datePart(s string) string {
+	if len(s) > len(dateLayout) {
+		return s[:len(dateLayout)]
+	}
+	return s
+}
+
+// จำนวนวันในเดือน
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
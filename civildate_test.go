@@ -0,0 +1,93 @@
+package aider
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestCivilDateAddMonths(t *testing.T) {
+	tests := []struct {
+		name string
+		date CivilDate
+		n    int
+		want CivilDate
+	}{
+		{name: "สิ้นเดือนมกราคมไปกุมภาพันธ์", date: CivilDate{2025, time.January, 31}, n: 1, want: CivilDate{2025, time.February, 28}},
+		{name: "ปีอธิกสุรทิน", date: CivilDate{2024, time.January, 31}, n: 1, want: CivilDate{2024, time.February, 29}},
+		{name: "ข้ามปี", date: CivilDate{2024, time.November, 30}, n: 3, want: CivilDate{2025, time.February, 28}},
+		{name: "ย้อนหลัง", date: CivilDate{2025, time.March, 31}, n: -1, want: CivilDate{2025, time.February, 28}},
+		{name: "วันที่ปกติ", date: CivilDate{2025, time.May, 15}, n: 12, want: CivilDate{2026, time.May, 15}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.AddMonths(tt.n); got != tt.want {
+				t.Errorf("AddMonths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got, want := (CivilDate{2024, time.February, 29}).AddYears(1), (CivilDate{2025, time.February, 28}); got != want {
+		t.Errorf("AddYears() = %v, want %v", got, want)
+	}
+}
+
+func TestCivilDateArithmetic(t *testing.T) {
+	a := CivilDate{2024, time.December, 30}
+	b := CivilDate{2025, time.January, 2}
+
+	if got := b.DaysSince(a); got != 3 {
+		t.Errorf("DaysSince() = %v, want 3", got)
+	}
+	if got := a.DaysSince(b); got != -3 {
+		t.Errorf("DaysSince() = %v, want -3", got)
+	}
+	if !a.Before(b) || b.Compare(a) != 1 || !a.Equal(a) {
+		t.Errorf("Compare() returned unexpected order")
+	}
+	if got := a.AddDays(3); got != b {
+		t.Errorf("AddDays() = %v, want %v", got, b)
+	}
+	if got := len(CivilDatesBetween(a, b)); got != 4 {
+		t.Errorf("CivilDatesBetween() len = %v, want 4", got)
+	}
+	if (CivilDate{2025, time.February, 30}).IsValid() {
+		t.Errorf("IsValid() = true for 2025-02-30")
+	}
+
+	// เวลา 23:30 UTC เป็นวันถัดไปตามเวลาไทย
+	utc := time.Date(2025, 1, 1, 23, 30, 0, 0, time.UTC)
+	if got, want := CivilDateOf(utc.In(loadLocation())), (CivilDate{2025, time.January, 2}); got != want {
+		t.Errorf("CivilDateOf() = %v, want %v", got, want)
+	}
+	if got := DaysBetween(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)); got != -2 {
+		t.Errorf("DaysBetween() = %v, want -2", got)
+	}
+}
+
+func TestCivilDateMarshal(t *testing.T) {
+	type payload struct {
+		Birth CivilDate `json:"birth"`
+		Due   CivilDate `json:"due"`
+	}
+	b, err := json.Marshal(payload{Birth: CivilDate{1990, time.March, 5}})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `{"birth":"1990-03-05","due":null}`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+
+	var p payload
+	if err := json.Unmarshal([]byte(`{"birth":"1990-03-05","due":"0000-00-00"}`), &p); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if p.Birth != (CivilDate{1990, time.March, 5}) || !p.Due.IsZero() {
+		t.Errorf("json.Unmarshal() = %+v", p)
+	}
+
+	var d CivilDate
+	if err := d.Scan([]byte("2025-02-21 14:30:00")); err != nil || d != (CivilDate{2025, time.February, 21}) {
+		t.Errorf("Scan() = %v, %v", d, err)
+	}
+}
@@ -0,0 +1,115 @@
+package aider
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock แหล่งเวลาปัจจุบันที่ฟังก์ชัน "เวลาปัจจุบัน" ทั้งหมดในแพ็กเกจใช้
+// สามารถเปลี่ยนเป็น FakeClock เพื่อทดสอบช่วงเวลาเที่ยงคืน สิ้นเดือน หรือการหมดอายุของ token ได้
+type Clock interface {
+	Now() time.Time
+}
+
+// นาฬิกาจริงของระบบ
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+var (
+	clockMu sync.RWMutex
+	clock   Clock = realClock{}
+)
+
+// RealClock คืนนาฬิกาจริงของระบบ (ค่าเริ่มต้นของแพ็กเกจ)
+func RealClock() Clock {
+	return realClock{}
+}
+
+// SetClock กำหนดนาฬิกาที่ใช้ทั้งแพ็กเกจ ถ้าส่ง nil จะกลับไปใช้นาฬิกาจริง
+func SetClock(c Clock) {
+	if c == nil {
+		c = realClock{}
+	}
+	clockMu.Lock()
+	defer clockMu.Unlock()
+	clock = c
+}
+
+// CurrentClock คืนนาฬิกาที่แพ็กเกจใช้อยู่
+func CurrentClock() Clock {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+	return clock
+}
+
+// เวลาปัจจุบันจากนาฬิกาของแพ็กเกจ
+func now() time.Time {
+	return CurrentClock().Now()
+}
+
+// FakeClock นาฬิกาจำลองสำหรับการทดสอบ เวลาจะไม่เดินเองจนกว่าจะเรียก Set หรือ Advance
+type FakeClock struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+// NewFakeClock สร้างนาฬิกาจำลองที่เริ่มต้นที่เวลา t
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{t: t}
+}
+
+// Now คืนเวลาปัจจุบันของนาฬิกาจำลอง
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t
+}
+
+// Set ตั้งเวลาของนาฬิกาจำลอง
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = t
+}
+
+// Advance เลื่อนเวลาของนาฬิกาจำลองไปข้างหน้า (หรือถอยหลังถ้า d ติดลบ)
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = c.t.Add(d)
+}
+
+type clockContextKey struct{}
+
+// WithClock แนบนาฬิกาไปกับ context สำหรับกำหนดเวลาแยกตาม request
+func WithClock(ctx context.Context, c Clock) context.Context {
+	return context.WithValue(ctx, clockContextKey{}, c)
+}
+
+// ClockFromContext คืนนาฬิกาที่แนบมากับ context ถ้าไม่มีจะคืนนาฬิกาของแพ็กเกจ
+func ClockFromContext(ctx context.Context) Clock {
+	if ctx != nil {
+		if c, ok := ctx.Value(clockContextKey{}).(Clock); ok && c != nil {
+			return c
+		}
+	}
+	return CurrentClock()
+}
+
+// NowContext คืนเวลาปัจจุบันจากนาฬิกาใน context
+func NowContext(ctx context.Context) time.Time {
+	return ClockFromContext(ctx).Now()
+
+	/*
+		Ex.
+		fake := NewFakeClock(time.Date(2025, 1, 31, 23, 59, 59, 0, loadLocation()))
+		ctx := WithClock(context.Background(), fake)
+		NowContext(ctx) // 2025-01-31 23:59:59 +0700
+		fake.Advance(time.Second)
+		NowContext(ctx) // 2025-02-01 00:00:00 +0700
+	*/
+}
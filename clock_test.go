@@ -0,0 +1,43 @@
+package aider
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	loc := loadLocation()
+	fake := NewFakeClock(time.Date(2025, 1, 31, 23, 59, 59, 0, loc))
+	SetClock(fake)
+	defer SetClock(nil)
+
+	if got, want := GetToday(), time.Date(2025, 1, 31, 0, 0, 0, 0, loc); !got.Equal(want) {
+		t.Errorf("GetToday() = %v, want %v", got, want)
+	}
+	if got, want := DateTimeNow(), "2025-01-31 23:59:59"; got != want {
+		t.Errorf("DateTimeNow() = %v, want %v", got, want)
+	}
+
+	fake.Advance(time.Second)
+	if got, want := GetToday(), time.Date(2025, 2, 1, 0, 0, 0, 0, loc); !got.Equal(want) {
+		t.Errorf("GetToday() after Advance = %v, want %v", got, want)
+	}
+	if got, want := GetYesterday(), time.Date(2025, 1, 31, 0, 0, 0, 0, loc); !got.Equal(want) {
+		t.Errorf("GetYesterday() = %v, want %v", got, want)
+	}
+	if got, want := TimeNow().DateTime(), "2025-02-01 00:00:00"; got != want {
+		t.Errorf("TimeNow().DateTime() = %v, want %v", got, want)
+	}
+}
+
+func TestClockFromContext(t *testing.T) {
+	fixed := time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)
+	ctx := WithClock(context.Background(), NewFakeClock(fixed))
+	if got := NowContext(ctx); !got.Equal(fixed) {
+		t.Errorf("NowContext() = %v, want %v", got, fixed)
+	}
+	if _, ok := ClockFromContext(context.Background()).(realClock); !ok {
+		t.Errorf("ClockFromContext() without clock should return the package clock")
+	}
+}
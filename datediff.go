@@ -0,0 +1,105 @@
+package aider
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DateDiff ผลต่างระหว่างวันเวลาสองค่าแบบปฏิทิน (ปี เดือน วัน ชั่วโมง นาที วินาที)
+type DateDiff struct {
+	Years    int
+	Months   int
+	Days     int
+	Hours    int
+	Minutes  int
+	Seconds  int
+	Negative bool // true ถ้าเวลาปลายทางอยู่ก่อนเวลาเริ่มต้น
+}
+
+// ชื่อหน่วยของ DateDiff แต่ละภาษา (เอกพจน์, พหูพจน์)
+var dateDiffUnits = map[string][5][2]string{
+	"th": {{"ปี", "ปี"}, {"เดือน", "เดือน"}, {"วัน", "วัน"}, {"ชั่วโมง", "ชั่วโมง"}, {"นาที", "นาที"}},
+	"en": {{"year", "years"}, {"month", "months"}, {"day", "days"}, {"hour", "hours"}, {"minute", "minutes"}},
+}
+
+// Diff คำนวณผลต่างแบบปฏิทินจาก from ถึง to ตามโซนเวลาของแพ็กเกจ
+// การนับเดือนจะปัดวันสิ้นเดือน เช่น 31 ม.ค. ถึง 28 ก.พ. (ปีปกติ) นับเป็น 1 เดือนพอดี
+func Diff(from, to time.Time) DateDiff {
+	loc := loadLocation()
+	from, to = from.In(loc), to.In(loc)
+
+	var diff DateDiff
+	if to.Before(from) {
+		from, to = to, from
+		diff.Negative = true
+	}
+
+	months := (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+	anchor := addMonthsClamped(from, months)
+	if anchor.After(to) {
+		months--
+		anchor = addMonthsClamped(from, months)
+	}
+
+	days := CivilDateOf(to).DaysSince(CivilDateOf(anchor))
+	mid := anchor.AddDate(0, 0, days)
+	if mid.After(to) {
+		days--
+		mid = anchor.AddDate(0, 0, days)
+	}
+
+	rem := to.Sub(mid)
+	diff.Years, diff.Months = months/12, months%12
+	diff.Days = days
+	diff.Hours = int(rem / time.Hour)
+	diff.Minutes = int(rem % time.Hour / time.Minute)
+	diff.Seconds = int(rem % time.Minute / time.Second)
+	return diff
+
+	/*
+		Ex.
+		from := time.Date(2022, 11, 16, 8, 0, 0, 0, loadLocation())
+		to := time.Date(2025, 2, 21, 10, 30, 0, 0, loadLocation())
+		d := Diff(from, to) // {Years: 2, Months: 3, Days: 5, Hours: 2, Minutes: 30}
+		d.Format("th")      // 2 ปี 3 เดือน 5 วัน 2 ชั่วโมง 30 นาที
+	*/
+}
+
+// Age คำนวณอายุ (ปี เดือน วัน) ของผู้ที่เกิดวันที่ birth ณ วันที่ on โดยไม่สนใจเวลาของวัน
+// ผู้ที่เกิด 29 ก.พ. จะครบปีในวันที่ 28 ก.พ. ของปีที่ไม่ใช่ปีอธิกสุรทิน
+func Age(birth, on time.Time) DateDiff {
+	loc := loadLocation()
+	return Diff(CivilDateOf(birth.In(loc)).In(loc), CivilDateOf(on.In(loc)).In(loc))
+}
+
+// Format แสดงผลต่างเป็นข้อความ เช่น "2 ปี 3 เดือน 5 วัน" หรือ "2 years 3 months 5 days"
+// แสดงเฉพาะหน่วยที่ไม่เป็น 0 (ไม่รวมวินาที) ถ้าทุกหน่วยเป็น 0 จะแสดง "0 วัน"
+func (d DateDiff) Format(language string) (string, error) {
+	units, ok := dateDiffUnits[language]
+	if !ok {
+		return "", ErrInvalidLanguage
+	}
+
+	var parts []string
+	for i, n := range []int{d.Years, d.Months, d.Days, d.Hours, d.Minutes} {
+		if n == 0 {
+			continue
+		}
+		label := units[i][0]
+		if n > 1 {
+			label = units[i][1]
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", n, label))
+	}
+	if len(parts) == 0 {
+		parts = append(parts, "0 "+units[2][1])
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// บวกเดือนให้กับ t โดยปัดวันที่ให้ไม่เกินวันสุดท้ายของเดือนปลายทาง และคงเวลาของวันไว้
+func addMonthsClamped(t time.Time, n int) time.Time {
+	d := CivilDateOf(t).AddMonths(n)
+	return time.Date(d.Year, d.Month, d.Day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
@@ -0,0 +1,86 @@
+package aider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	loc := loadLocation()
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want DateDiff
+	}{
+		{
+			name: "ปี เดือน วัน และเวลา",
+			from: time.Date(2022, 11, 16, 8, 0, 0, 0, loc),
+			to:   time.Date(2025, 2, 21, 10, 30, 15, 0, loc),
+			want: DateDiff{Years: 2, Months: 3, Days: 5, Hours: 2, Minutes: 30, Seconds: 15},
+		},
+		{
+			name: "เวลาของวันยังไม่ครบ",
+			from: time.Date(2025, 1, 1, 18, 0, 0, 0, loc),
+			to:   time.Date(2025, 1, 3, 9, 0, 0, 0, loc),
+			want: DateDiff{Days: 1, Hours: 15},
+		},
+		{
+			name: "สิ้นเดือนไปเดือนที่สั้นกว่า",
+			from: time.Date(2025, 1, 31, 0, 0, 0, 0, loc),
+			to:   time.Date(2025, 2, 28, 0, 0, 0, 0, loc),
+			want: DateDiff{Months: 1},
+		},
+		{
+			name: "ย้อนหลัง",
+			from: time.Date(2025, 3, 10, 0, 0, 0, 0, loc),
+			to:   time.Date(2025, 3, 1, 0, 0, 0, 0, loc),
+			want: DateDiff{Days: 9, Negative: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Diff(tt.from, tt.to); got != tt.want {
+				t.Errorf("Diff() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAge(t *testing.T) {
+	loc := loadLocation()
+	tests := []struct {
+		name  string
+		birth time.Time
+		on    time.Time
+		want  DateDiff
+	}{
+		{name: "ก่อนวันเกิด", birth: time.Date(1990, 5, 20, 0, 0, 0, 0, loc), on: time.Date(2025, 5, 19, 23, 0, 0, 0, loc), want: DateDiff{Years: 34, Months: 11, Days: 29}},
+		{name: "ตรงวันเกิด", birth: time.Date(1990, 5, 20, 0, 0, 0, 0, loc), on: time.Date(2025, 5, 20, 0, 0, 0, 0, loc), want: DateDiff{Years: 35}},
+		{name: "เกิด 29 ก.พ. ปีปกติ", birth: time.Date(2000, 2, 29, 0, 0, 0, 0, loc), on: time.Date(2001, 2, 28, 0, 0, 0, 0, loc), want: DateDiff{Years: 1}},
+		{name: "เกิด 29 ก.พ. ปีอธิกสุรทิน", birth: time.Date(2000, 2, 29, 0, 0, 0, 0, loc), on: time.Date(2004, 2, 28, 0, 0, 0, 0, loc), want: DateDiff{Years: 3, Months: 11, Days: 30}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Age(tt.birth, tt.on); got != tt.want {
+				t.Errorf("Age() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateDiffFormat(t *testing.T) {
+	d := DateDiff{Years: 2, Months: 3, Days: 5}
+	if got, _ := d.Format("th"); got != "2 ปี 3 เดือน 5 วัน" {
+		t.Errorf("Format(th) = %v", got)
+	}
+	if got, _ := (DateDiff{Years: 1, Days: 2, Hours: 1}).Format("en"); got != "1 year 2 days 1 hour" {
+		t.Errorf("Format(en) = %v", got)
+	}
+	if got, _ := (DateDiff{}).Format("en"); got != "0 days" {
+		t.Errorf("Format(en) zero = %v", got)
+	}
+	if _, err := d.Format("jp"); err == nil {
+		t.Errorf("Format() expected error for invalid language")
+	}
+}
@@ -0,0 +1,322 @@
+package aider
+
+import (
+	"sort"
+	"time"
+)
+
+// TimeRange ช่วงเวลาระหว่าง Start ถึง End
+// ค่าเริ่มต้นเป็นช่วงแบบครึ่งเปิด [Start, End) ถ้า Closed เป็น true จะรวมเวลา End ด้วย [Start, End]
+type TimeRange struct {
+	Start  time.Time
+	End    time.Time
+	Closed bool
+}
+
+// NewTimeRange สร้างช่วงเวลาแบบครึ่งเปิด [start, end)
+func NewTimeRange(start, end time.Time) TimeRange {
+	return TimeRange{Start: start, End: end}
+}
+
+// NewClosedTimeRange สร้างช่วงเวลาแบบปิด [start, end]
+func NewClosedTimeRange(start, end time.Time) TimeRange {
+	return TimeRange{Start: start, End: end, Closed: true}
+}
+
+// IsEmpty ตรวจสอบว่าช่วงเวลาว่าง (ไม่มีเวลาใดอยู่ในช่วง) หรือไม่
+func (r TimeRange) IsEmpty() bool {
+	if r.Closed {
+		return r.End.Before(r.Start)
+	}
+	return !r.Start.Before(r.End)
+}
+
+// Duration ความยาวของช่วงเวลา (ช่วงว่างคืนค่า 0)
+func (r TimeRange) Duration() time.Duration {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.End.Sub(r.Start)
+}
+
+// Contains ตรวจสอบว่าเวลา t อยู่ในช่วงหรือไม่
+func (r TimeRange) Contains(t time.Time) bool {
+	if t.Before(r.Start) {
+		return false
+	}
+	return t.Before(r.End) || (r.Closed && t.Equal(r.End))
+}
+
+// Overlaps ตรวจสอบว่าสองช่วงมีเวลาร่วมกันหรือไม่
+func (r TimeRange) Overlaps(o TimeRange) bool {
+	_, ok := r.Intersect(o)
+	return ok
+}
+
+// Intersect คืนช่วงเวลาที่ซ้อนทับกัน ถ้าไม่ซ้อนทับจะคืน false
+func (r TimeRange) Intersect(o TimeRange) (TimeRange, bool) {
+	if r.IsEmpty() || o.IsEmpty() {
+		return TimeRange{}, false
+	}
+	result := TimeRange{Start: r.Start, End: r.End, Closed: r.Closed}
+	if o.Start.After(result.Start) {
+		result.Start = o.Start
+	}
+	switch {
+	case o.End.Before(r.End):
+		result.End, result.Closed = o.End, o.Closed
+	case o.End.Equal(r.End):
+		result.Closed = r.Closed && o.Closed
+	}
+	if result.IsEmpty() {
+		return TimeRange{}, false
+	}
+	return result, true
+}
+
+// Union รวมสองช่วงที่ซ้อนทับหรือต่อเนื่องกันเป็นช่วงเดียว ถ้าแยกจากกันจะคืน false
+func (r TimeRange) Union(o TimeRange) (TimeRange, bool) {
+	switch {
+	case r.IsEmpty():
+		return o, !o.IsEmpty()
+	case o.IsEmpty():
+		return r, true
+	case r.End.Before(o.Start) || o.End.Before(r.Start):
+		return TimeRange{}, false
+	}
+	// End ที่ชนกับ Start ของอีกช่วงพอดีถือว่าต่อเนื่องกัน เพราะ Start รวมอยู่ในช่วงเสมอ
+	result := TimeRange{Start: r.Start, End: r.End, Closed: r.Closed}
+	if o.Start.Before(result.Start) {
+		result.Start = o.Start
+	}
+	switch {
+	case o.End.After(r.End):
+		result.End, result.Closed = o.End, o.Closed
+	case o.End.Equal(r.End):
+		result.Closed = r.Closed || o.Closed
+	}
+	return result, true
+}
+
+// MergeTimeRanges รวมช่วงเวลาที่ซ้อนทับหรือต่อเนื่องกัน คืนผลลัพธ์เรียงตามเวลาเริ่มต้น
+func MergeTimeRanges(ranges []TimeRange) []TimeRange {
+	sorted := make([]TimeRange, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	var result []TimeRange
+	for _, r := range sorted {
+		if n := len(result); n > 0 {
+			if merged, ok := result[n-1].Union(r); ok {
+				result[n-1] = merged
+				continue
+			}
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+// SplitByDay แบ่งช่วงเวลาเป็นรายวัน (ตัดที่เวลา 00:00:00 ตามโซนเวลาของ Start)
+func (r TimeRange) SplitByDay() []TimeRange {
+	return r.split(func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+	})
+}
+
+// SplitByWeek แบ่งช่วงเวลาเป็นรายสัปดาห์ โดยเริ่มสัปดาห์ที่วัน firstDay
+func (r TimeRange) SplitByWeek(firstDay time.Weekday) []TimeRange {
+	return r.split(func(t time.Time) time.Time {
+		days := (int(firstDay) - int(t.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		return time.Date(t.Year(), t.Month(), t.Day()+days, 0, 0, 0, 0, t.Location())
+	})
+}
+
+// SplitByMonth แบ่งช่วงเวลาเป็นรายเดือน (ตัดที่วันที่ 1 ของเดือน)
+func (r TimeRange) SplitByMonth() []TimeRange {
+	return r.split(func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+	})
+}
+
+// SplitEvery แบ่งช่วงเวลาเป็นช่วงย่อยยาว d เท่า ๆ กัน ช่วงสุดท้ายอาจสั้นกว่า d
+func (r TimeRange) SplitEvery(d time.Duration) []TimeRange {
+	if d <= 0 {
+		return nil
+	}
+	return r.split(func(t time.Time) time.Time {
+		return t.Add(d)
+	})
+}
+
+// แบ่งช่วงเวลาตามจุดตัดที่ได้จาก next ช่วงย่อยเป็นแบบครึ่งเปิด ยกเว้นช่วงสุดท้ายที่ใช้รูปแบบเดิม
+func (r TimeRange) split(next func(time.Time) time.Time) []TimeRange {
+	if r.IsEmpty() {
+		return nil
+	}
+	var result []TimeRange
+	start := r.Start
+	for {
+		end := next(start)
+		if end.Before(r.End) {
+			result = append(result, NewTimeRange(start, end))
+			start = end
+			continue
+		}
+		last := TimeRange{Start: start, End: r.End, Closed: r.Closed}
+		if !last.IsEmpty() {
+			result = append(result, last)
+		}
+		return result
+	}
+}
+
+// DateRange ช่วงวันที่ตั้งแต่ Start ถึง End โดยรวมทั้งวันแรกและวันสุดท้าย
+type DateRange struct {
+	Start CivilDate
+	End   CivilDate
+}
+
+// NewDateRange สร้างช่วงวันที่ตั้งแต่ start ถึง end (รวมวันสุดท้าย)
+func NewDateRange(start, end CivilDate) DateRange {
+	return DateRange{Start: start, End: end}
+}
+
+// IsEmpty ตรวจสอบว่าช่วงวันที่ว่าง (End อยู่ก่อน Start) หรือไม่
+func (r DateRange) IsEmpty() bool {
+	return r.End.Before(r.Start)
+}
+
+// Days จำนวนวันในช่วง (รวมวันแรกและวันสุดท้าย)
+func (r DateRange) Days() int {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.End.DaysSince(r.Start) + 1
+}
+
+// Contains ตรวจสอบว่าวันที่ d อยู่ในช่วงหรือไม่
+func (r DateRange) Contains(d CivilDate) bool {
+	return !d.Before(r.Start) && !d.After(r.End)
+}
+
+// Overlaps ตรวจสอบว่าสองช่วงมีวันที่ร่วมกันหรือไม่
+func (r DateRange) Overlaps(o DateRange) bool {
+	_, ok := r.Intersect(o)
+	return ok
+}
+
+// Intersect คืนช่วงวันที่ที่ซ้อนทับกัน ถ้าไม่ซ้อนทับจะคืน false
+func (r DateRange) Intersect(o DateRange) (DateRange, bool) {
+	if r.IsEmpty() || o.IsEmpty() {
+		return DateRange{}, false
+	}
+	result := r
+	if o.Start.After(result.Start) {
+		result.Start = o.Start
+	}
+	if o.End.Before(result.End) {
+		result.End = o.End
+	}
+	if result.IsEmpty() {
+		return DateRange{}, false
+	}
+	return result, true
+}
+
+// Union รวมสองช่วงที่ซ้อนทับหรือติดกัน (วันสุดท้ายของช่วงหนึ่งต่อกับวันแรกของอีกช่วง) เป็นช่วงเดียว
+func (r DateRange) Union(o DateRange) (DateRange, bool) {
+	switch {
+	case r.IsEmpty():
+		return o, !o.IsEmpty()
+	case o.IsEmpty():
+		return r, true
+	case r.End.AddDays(1).Before(o.Start) || o.End.AddDays(1).Before(r.Start):
+		return DateRange{}, false
+	}
+	result := r
+	if o.Start.Before(result.Start) {
+		result.Start = o.Start
+	}
+	if o.End.After(result.End) {
+		result.End = o.End
+	}
+	return result, true
+}
+
+// MergeDateRanges รวมช่วงวันที่ที่ซ้อนทับหรือติดกัน คืนผลลัพธ์เรียงตามวันเริ่มต้น
+func MergeDateRanges(ranges []DateRange) []DateRange {
+	sorted := make([]DateRange, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	var result []DateRange
+	for _, r := range sorted {
+		if n := len(result); n > 0 {
+			if merged, ok := result[n-1].Union(r); ok {
+				result[n-1] = merged
+				continue
+			}
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+// Dates คืนรายการวันที่ทั้งหมดในช่วง
+func (r DateRange) Dates() []CivilDate {
+	return CivilDatesBetween(r.Start, r.End)
+}
+
+// SplitByWeek แบ่งช่วงวันที่เป็นรายสัปดาห์ โดยเริ่มสัปดาห์ที่วัน firstDay
+func (r DateRange) SplitByWeek(firstDay time.Weekday) []DateRange {
+	return r.split(func(d CivilDate) CivilDate {
+		days := (int(firstDay) - int(d.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		return d.AddDays(days)
+	})
+}
+
+// SplitByMonth แบ่งช่วงวันที่เป็นรายเดือน
+func (r DateRange) SplitByMonth() []DateRange {
+	return r.split(func(d CivilDate) CivilDate {
+		return NewCivilDate(d.Year, d.Month+1, 1)
+	})
+}
+
+// TimeRange แปลงเป็นช่วงเวลาแบบครึ่งเปิด ตั้งแต่ 00:00:00 ของวันแรกถึง 00:00:00 ของวันถัดจากวันสุดท้าย
+func (r DateRange) TimeRange(loc *time.Location) TimeRange {
+	return NewTimeRange(r.Start.In(loc), r.End.AddDays(1).In(loc))
+}
+
+// แบ่งช่วงวันที่ โดย next คืนวันแรกของช่วงย่อยถัดไป
+func (r DateRange) split(next func(CivilDate) CivilDate) []DateRange {
+	var result []DateRange
+	for start := r.Start; !start.After(r.End); {
+		n := next(start)
+		end := n.AddDays(-1)
+		if end.After(r.End) {
+			end = r.End
+		}
+		result = append(result, DateRange{Start: start, End: end})
+		start = n
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package aider
+
+import (
+	"errors"
+	"time"
+)
+
+// ตัวคั่นช่วงวันที่ ใช้ขีดสั้น (en dash) ติดกันเมื่อคั่นตัวเลข และเว้นวรรคเมื่อคั่นวันที่ที่มีชื่อเดือน
+const (
+	dateRangeDash      = "–"
+	dateRangeSeparator = " – "
+)
+
+// DateRangeFormatOptions ตัวเลือกของ FormatDateRange
+type DateRangeFormatOptions struct {
+	LongMonth bool // แสดงชื่อเดือนเต็ม (กุมภาพันธ์ / February) แทนชื่อย่อ (ก.พ. / Feb)
+	WithTime  bool // แสดงเวลา HH:mm ของวันเริ่มและวันสิ้นสุดด้วย
+}
+
+// FormatDateRange แสดงช่วงวันที่แบบย่อ โดยไม่แสดงเดือนและปีซ้ำถ้าเหมือนกัน เช่น
+// "1–5 ก.พ. 2568", "28 ก.พ. – 3 มี.ค. 2568", "30 ธ.ค. 2567 – 2 ม.ค. 2568"
+// end คือวันสุดท้ายของช่วง (รวมวันนั้นด้วย) เวลาจะถูกแปลงเป็นโซนเวลาของแพ็กเกจ (ค่าเริ่มต้น Asia/Bangkok) ก่อนจัดรูปแบบ
+// ปีแสดงตามศักราชของภาษา (th = พ.ศ., en = ค.ศ.)
+func FormatDateRange(start, end time.Time, language string, opts DateRangeFormatOptions) (string, error) {
+	if _, err := lookupLocale(language); err != nil {
+		return "", err
+	}
+	loc := loadLocation()
+	start, end = start.In(loc), end.In(loc)
+	if end.Before(start) {
+		return "", errors.New("invalid date range: end before start")
+	}
+
+	month := "MMM"
+	if opts.LongMonth {
+		month = "MMMM"
+	}
+	format := func(t time.Time, layout string) string {
+		s, _ := FormatThai(t, layout, language)
+		return s
+	}
+
+	sameYear := start.Year() == end.Year()
+	sameMonth := sameYear && start.Month() == end.Month()
+	sameDay := sameMonth && start.Day() == end.Day()
+
+	if opts.WithTime {
+		if sameDay {
+			return format(start, "D "+month+" EEEE HH:mm") + dateRangeDash + format(end, "HH:mm"), nil
+		}
+		layout := "D " + month + " EEEE HH:mm"
+		return format(start, layout) + dateRangeSeparator + format(end, layout), nil
+	}
+
+	full := "D " + month + " EEEE"
+	switch {
+	case sameDay:
+		return format(start, full), nil
+	case sameMonth:
+		return format(start, "D") + dateRangeDash + format(end, full), nil
+	case sameYear:
+		return format(start, "D "+month) + dateRangeSeparator + format(end, full), nil
+	}
+	return format(start, full) + dateRangeSeparator + format(end, full), nil
+
+	/*
+		Ex.
+		d := func(m time.Month, day int) time.Time { return time.Date(2025, m, day, 0, 0, 0, 0, loadLocation()) }
+		FormatDateRange(d(2, 1), d(2, 5), "th", DateRangeFormatOptions{})                  // 1–5 ก.พ. 2568
+		FormatDateRange(d(2, 28), d(3, 3), "th", DateRangeFormatOptions{})                 // 28 ก.พ. – 3 มี.ค. 2568
+		FormatDateRange(d(2, 1), d(2, 5), "en", DateRangeFormatOptions{LongMonth: true})   // 1–5 February 2025
+		FormatDateRange(d(2, 1).Add(9*time.Hour), d(2, 1).Add(12*time.Hour), "th",
+			DateRangeFormatOptions{WithTime: true})                                        // 1 ก.พ. 2568 09:00–12:00
+	*/
+}
+
+// Format แสดงช่วงวันที่แบบย่อด้วย FormatDateRange (ไม่สนใจตัวเลือก WithTime)
+func (r DateRange) Format(language string, opts DateRangeFormatOptions) (string, error) {
+	loc := loadLocation()
+	opts.WithTime = false
+	return FormatDateRange(r.Start.In(loc), r.End.In(loc), language, opts)
+}
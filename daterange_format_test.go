@@ -0,0 +1,66 @@
+package aider
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFormatDateRange(t *testing.T) {
+	loc := loadLocation()
+	d := func(year int, month time.Month, day, hour, min int) time.Time {
+		return time.Date(year, month, day, hour, min, 0, 0, loc)
+	}
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		language string
+		opts     DateRangeFormatOptions
+		want     string
+	}{
+		{name: "วันเดียว", start: d(2025, 2, 1, 0, 0), end: d(2025, 2, 1, 0, 0), language: "th", want: "1 ก.พ. 2568"},
+		{name: "เดือนเดียวกัน", start: d(2025, 2, 1, 0, 0), end: d(2025, 2, 5, 0, 0), language: "th", want: "1–5 ก.พ. 2568"},
+		{name: "ข้ามเดือน", start: d(2025, 2, 28, 0, 0), end: d(2025, 3, 3, 0, 0), language: "th", want: "28 ก.พ. – 3 มี.ค. 2568"},
+		{name: "ข้ามปี", start: d(2024, 12, 30, 0, 0), end: d(2025, 1, 2, 0, 0), language: "th", want: "30 ธ.ค. 2567 – 2 ม.ค. 2568"},
+		{name: "ชื่อเดือนเต็ม", start: d(2025, 2, 28, 0, 0), end: d(2025, 3, 3, 0, 0), language: "th", opts: DateRangeFormatOptions{LongMonth: true}, want: "28 กุมภาพันธ์ – 3 มีนาคม 2568"},
+		{name: "อังกฤษ เดือนเดียวกัน", start: d(2025, 2, 1, 0, 0), end: d(2025, 2, 5, 0, 0), language: "en", want: "1–5 Feb 2025"},
+		{name: "อังกฤษ ข้ามปี ชื่อเดือนเต็ม", start: d(2024, 12, 30, 0, 0), end: d(2025, 1, 2, 0, 0), language: "en", opts: DateRangeFormatOptions{LongMonth: true}, want: "30 December 2024 – 2 January 2025"},
+		{name: "มีเวลา วันเดียว", start: d(2025, 2, 1, 9, 0), end: d(2025, 2, 1, 12, 30), language: "th", opts: DateRangeFormatOptions{WithTime: true}, want: "1 ก.พ. 2568 09:00–12:30"},
+		{name: "มีเวลา หลายวัน", start: d(2025, 2, 1, 9, 0), end: d(2025, 2, 3, 17, 0), language: "en", opts: DateRangeFormatOptions{WithTime: true}, want: "1 Feb 2025 09:00 – 3 Feb 2025 17:00"},
+		// 2025-01-31 20:00 UTC คือ 1 ก.พ. 2568 03:00 ตามเวลาไทย
+		{name: "แปลงเป็นเวลาไทยก่อน", start: time.Date(2025, 1, 31, 20, 0, 0, 0, time.UTC), end: d(2025, 2, 5, 0, 0), language: "th", want: "1–5 ก.พ. 2568"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatDateRange(tt.start, tt.end, tt.language, tt.opts)
+			if err != nil {
+				t.Fatalf("FormatDateRange() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatDateRange() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatDateRangeErrors(t *testing.T) {
+	start := time.Date(2025, 2, 5, 0, 0, 0, 0, loadLocation())
+	if _, err := FormatDateRange(start, start.AddDate(0, 0, -1), "th", DateRangeFormatOptions{}); err == nil {
+		t.Error("FormatDateRange() with end before start should return error")
+	}
+	if _, err := FormatDateRange(start, start, "xx", DateRangeFormatOptions{}); !errors.Is(err, ErrInvalidLanguage) {
+		t.Errorf("FormatDateRange() error = %v, want ErrInvalidLanguage", err)
+	}
+}
+
+func TestDateRangeFormat(t *testing.T) {
+	r := NewDateRange(NewCivilDate(2025, 2, 28), NewCivilDate(2025, 3, 3))
+	got, err := r.Format("th", DateRangeFormatOptions{WithTime: true})
+	if err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+	if want := "28 ก.พ. – 3 มี.ค. 2568"; got != want {
+		t.Errorf("Format() = %q, want %q", got, want)
+	}
+}
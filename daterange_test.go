@@ -0,0 +1,104 @@
+package aider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeRangeIntersect(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2025, 2, 21, hour, 0, 0, 0, loadLocation())
+	}
+	tests := []struct {
+		name   string
+		a, b   TimeRange
+		want   TimeRange
+		wantOK bool
+	}{
+		{name: "ซ้อนทับบางส่วน", a: NewTimeRange(at(8), at(12)), b: NewTimeRange(at(10), at(14)), want: NewTimeRange(at(10), at(12)), wantOK: true},
+		{name: "ครึ่งเปิดต่อกันพอดี", a: NewTimeRange(at(8), at(10)), b: NewTimeRange(at(10), at(12)), wantOK: false},
+		{name: "ช่วงปิดชนกันที่จุดเดียว", a: NewClosedTimeRange(at(8), at(10)), b: NewTimeRange(at(10), at(12)), want: NewClosedTimeRange(at(10), at(10)), wantOK: true},
+		{name: "อยู่ภายในอีกช่วง", a: NewClosedTimeRange(at(8), at(18)), b: NewTimeRange(at(9), at(10)), want: NewTimeRange(at(9), at(10)), wantOK: true},
+		{name: "แยกจากกัน", a: NewTimeRange(at(8), at(9)), b: NewTimeRange(at(10), at(11)), wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.a.Intersect(tt.b)
+			if ok != tt.wantOK {
+				t.Fatalf("Intersect() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && (!got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End) || got.Closed != tt.want.Closed) {
+				t.Errorf("Intersect() = %v, want %v", got, tt.want)
+			}
+			if tt.a.Overlaps(tt.b) != tt.wantOK {
+				t.Errorf("Overlaps() = %v, want %v", !tt.wantOK, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMergeTimeRanges(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2025, 2, 21, hour, 0, 0, 0, loadLocation())
+	}
+	got := MergeTimeRanges([]TimeRange{
+		NewTimeRange(at(13), at(15)),
+		NewTimeRange(at(8), at(10)),
+		NewTimeRange(at(10), at(12)),
+		NewTimeRange(at(14), at(16)),
+		NewTimeRange(at(20), at(20)),
+	})
+	if len(got) != 2 {
+		t.Fatalf("MergeTimeRanges() = %v, want 2 ranges", got)
+	}
+	if !got[0].Start.Equal(at(8)) || !got[0].End.Equal(at(12)) || !got[1].Start.Equal(at(13)) || !got[1].End.Equal(at(16)) {
+		t.Errorf("MergeTimeRanges() = %v", got)
+	}
+	if d := got[0].Duration(); d != 4*time.Hour {
+		t.Errorf("Duration() = %v, want 4h", d)
+	}
+}
+
+func TestTimeRangeSplit(t *testing.T) {
+	loc := loadLocation()
+	r := NewTimeRange(time.Date(2025, 1, 30, 12, 0, 0, 0, loc), time.Date(2025, 3, 2, 0, 0, 0, 0, loc))
+
+	if got := len(r.SplitByDay()); got != 31 {
+		t.Errorf("SplitByDay() len = %v, want 31", got)
+	}
+	months := r.SplitByMonth()
+	if len(months) != 3 || !months[1].Start.Equal(time.Date(2025, 2, 1, 0, 0, 0, 0, loc)) {
+		t.Errorf("SplitByMonth() = %v", months)
+	}
+	// 30 ม.ค. 2568 เป็นวันพฤหัสบดี สัปดาห์แรกจึงสิ้นสุดก่อนวันจันทร์ที่ 3 ก.พ.
+	weeks := r.SplitByWeek(time.Monday)
+	if len(weeks) != 5 || !weeks[0].End.Equal(time.Date(2025, 2, 3, 0, 0, 0, 0, loc)) {
+		t.Errorf("SplitByWeek() = %v", weeks)
+	}
+	slots := NewTimeRange(time.Date(2025, 1, 1, 9, 0, 0, 0, loc), time.Date(2025, 1, 1, 10, 40, 0, 0, loc)).SplitEvery(30 * time.Minute)
+	if len(slots) != 4 || slots[3].Duration() != 10*time.Minute {
+		t.Errorf("SplitEvery() = %v", slots)
+	}
+}
+
+func TestDateRange(t *testing.T) {
+	a := NewDateRange(CivilDate{2025, 1, 1}, CivilDate{2025, 1, 10})
+	b := NewDateRange(CivilDate{2025, 1, 11}, CivilDate{2025, 2, 5})
+
+	if a.Overlaps(b) {
+		t.Errorf("Overlaps() = true for adjacent ranges")
+	}
+	u, ok := a.Union(b)
+	if !ok || u.Days() != 36 {
+		t.Errorf("Union() = %v, %v", u, ok)
+	}
+	if got := len(u.SplitByMonth()); got != 2 {
+		t.Errorf("SplitByMonth() len = %v, want 2", got)
+	}
+	if !u.Contains(CivilDate{2025, 2, 5}) || u.Contains(CivilDate{2025, 2, 6}) {
+		t.Errorf("Contains() returned unexpected result")
+	}
+	if got := u.TimeRange(time.UTC).Duration(); got != 36*24*time.Hour {
+		t.Errorf("TimeRange().Duration() = %v", got)
+	}
+}
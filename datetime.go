@@ -1,6 +1,7 @@
 package aider
 
 import (
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 const (
 	languageTh     string = "th"
 	dateLayout     string = "2006-01-02"          //รูปแบบของวันที่ใน Go
+	mysqlZeroDate  string = "0000-00-00"          //วันที่ว่างของ MySQL
 	timeLayout     string = "15:04:05"            //รูปแบบของเวลาใน Go
 	datetimeLayout string = "2006-01-02 15:04:05" //รูปแบบวันเวลาใน Go
 )
@@ -20,105 +22,33 @@ var thaiMonths = []string{
 	"ก.ค.", "ส.ค.", "ก.ย.", "ต.ค.", "พ.ย.", "ธ.ค.",
 }
 
-var allowedLanguages = map[string]bool{
-	"en": true,
-	"th": true,
-}
-
-// LoadLocation returns The time zone.
-func loadLocation() *time.Location {
-	timeZone, _ := time.LoadLocation("Asia/Bangkok")
-	return timeZone
-}
-
 // FormatDate format date
+// คืนวันที่แบบเต็มภาษาอังกฤษและภาษาไทยตามโซนเวลาของแพ็กเกจ ใช้ LongDate สำหรับภาษาอื่น
 func FormatDate(dt *time.Time) (string, string) {
-	dateFmt := dt.In(loadLocation()).Format("02 January 2006")
-	return dateFormat(dateFmt, "en"), dateFormat(dateFmt, "th")
-}
-
-func dateFormat(dateFmt, language string) string {
-	if language == languageTh {
-		switch getMonth(dateFmt) {
-		case "January":
-			dateFmt = strings.Replace(dateFmt, "January", "มกราคม", 1)
-
-		case "February":
-			dateFmt = strings.Replace(dateFmt, "February", "กุมภาพันธ์", 1)
-
-		case "March":
-			dateFmt = strings.Replace(dateFmt, "March", "มีนาคม", 1)
-
-		case "April":
-			dateFmt = strings.Replace(dateFmt, "April", "เมษายน", 1)
-
-		case "May":
-			dateFmt = strings.Replace(dateFmt, "May", "พฤษภาคม", 1)
-
-		case "June":
-			dateFmt = strings.Replace(dateFmt, "June", "มิถุนายน", 1)
-
-		case "July":
-			dateFmt = strings.Replace(dateFmt, "July", "กรกฎาคม", 1)
-
-		case "August":
-			dateFmt = strings.Replace(dateFmt, "August", "สิงหาคม", 1)
-
-		case "September":
-			dateFmt = strings.Replace(dateFmt, "September", "กันยายน", 1)
-
-		case "October":
-			dateFmt = strings.Replace(dateFmt, "October", "ตุลาคม", 1)
-
-		case "November":
-			dateFmt = strings.Replace(dateFmt, "November", "พฤศจิกายน", 1)
-
-		case "December":
-			dateFmt = strings.Replace(dateFmt, "December", "ธันวาคม", 1)
-		}
-	}
-
-	return fmt.Sprintf("%s %s %s", getDay(dateFmt), getMonth(dateFmt), getYear(dateFmt, language))
-}
-
-func getDay(dateFmt string) string {
-	return strings.Split(dateFmt, " ")[0]
-}
-
-func getMonth(dateFmt string) string {
-	return strings.Split(dateFmt, " ")[1]
-}
-
-func getYear(dateFmt, language string) string {
-	year, _ := strconv.Atoi(strings.Split(dateFmt, " ")[2])
-	if language == languageTh {
-		return strconv.Itoa(year + 543)
-	}
-	return strconv.Itoa(year)
+	d := dt.In(loadLocation())
+	en, _ := LongDate(d, "en")
+	th, _ := LongDate(d, languageTh)
+	return en, th
 }
 
 // GetToday get datetime today timezone (th)
 func GetToday() time.Time {
-	timeNow := TimeNowLocationTH()
-	return time.Date(timeNow.Year(), timeNow.Month(), timeNow.Day(), 0, 0, 0, 0, timeNow.Location())
+	return GetTodayIn(loadLocation())
 }
 
 // GetYesterday get datetime yesterday timezone (th)
 func GetYesterday() time.Time {
-	timeNow := TimeNowLocationTH()
-	return time.Date(timeNow.Year(), timeNow.Month(), timeNow.Day()-1, 0, 0, 0, 0, timeNow.Location())
+	return GetYesterdayIn(loadLocation())
 }
 
 // GetNextDateTime get datetime tomorrow timezone (th)
 func GetNextDateTime(day int) time.Time {
-	timeNow := TimeNowLocationTH()
-	return time.Date(timeNow.Year(), timeNow.Month(), timeNow.Day()+day, 0, 0, 0, 0, timeNow.Location())
+	return GetNextDateTimeIn(loadLocation(), day)
 }
 
 // GetPreviousDateTime get datetime yesterday timezone (th)
 func GetPreviousDateTime(day int) time.Time {
-	timeNow := TimeNowLocationTH()
-	return time.Date(timeNow.Year(), timeNow.Month(), timeNow.Day()-day, 0, 0, 0, 0, timeNow.Location())
+	return GetPreviousDateTimeIn(loadLocation(), day)
 }
 
 // GetDateTimeByDate get datetime by date timezone (th)
@@ -129,37 +59,22 @@ func GetDateTimeByDate(day time.Time) time.Time {
 
 // TimeNowLocationTH get time location thai
 func TimeNowLocationTH() time.Time {
-	return time.Now().In(loadLocation())
+	return now().In(loadLocation())
 }
 
 // ShortDate short date time.Time To 21 Feb. 2025 OR 21 ก.พ. 2568
+// ภาษาที่ไม่ได้ลงทะเบียนจะคืนข้อความ "invalid language" ใช้ FormatShortDate หากต้องการ error
 func ShortDate(date time.Time, language string) string {
-	// ตรวจสอบว่า language ต้องเป็น "en" หรือ "th" เท่านั้น
-	if !allowedLanguages[language] {
-		return "invalid language"
-	}
-
-	dateFmt := date.Format("02 Jan. 2006")
-	if language == "en" {
-		return dateFmt
+	s, err := FormatShortDate(date, language)
+	if err != nil {
+		return ErrInvalidLanguage.Error()
 	}
-
-	day := strings.Split(dateFmt, " ")[0]
-	month := getMonthThai(date.Month())
-	year := getYear(dateFmt, language)
-
-	rr := fmt.Sprintf("%s %s %s", day, month, year)
-	fmt.Println("====>", rr)
-	return rr
+	return s
 }
 
-// ฟังก์ชันคืนค่าชื่อเดือนเป็นภาษาไทย
-func getMonthThai(month time.Month) string {
-	// time.Month มีค่าเริ่มต้นจาก 1 (January = 1) ดังนั้น index ต้องลบ 1
-	if month >= time.January && month <= time.December {
-		return thaiMonths[month-1]
-	}
-	return "" // คืนค่าว่างหากค่าเดือนผิดพลาด
+// FormatShortDate เหมือน ShortDate แต่คืน error เมื่อไม่พบภาษา
+func FormatShortDate(date time.Time, language string) (string, error) {
+	return formatLocaleLayout(date, language, func(l Locale) string { return l.ShortDateLayout })
 }
 
 // ใช้สำหรับ บวก (เพิ่ม) หรือ ลบ (ลด) ค่าของ ปี, เดือน, วัน, ชั่วโมง, นาที และวินาที ไปยังวันที่ที่ระบุในรูปแบบของสตริง (datetime) และคืนค่าวันที่ที่ถูกปรับแล้วกลับมาในรูปแบบเดิม
@@ -197,34 +112,72 @@ func ModifyDatetime(datetime string, year, month, day, hour, min, sec int) strin
 	*/
 }
 
-// ShortYearMonth short month
-func ShortYearMonth(date time.Time, language string) string {
-	if !allowedLanguages[language] {
-		return "invalid language"
+// ShiftDatetime บวกหรือลบเวลาเหมือน ModifyDatetime แต่รับข้อความทุกรูปแบบที่ ParseDateTime รองรับ
+// คืนผลลัพธ์ในรูปแบบ "2006-01-02 15:04:05" ตามโซนเวลาของแพ็กเกจ และคืน error ถ้าแปลงข้อความไม่ได้
+func ShiftDatetime(datetime string, year, month, day, hour, min, sec int) (string, error) {
+	dt, err := ParseDateTime(datetime)
+	if err != nil {
+		return "", err
 	}
-	dateFmt := date.Format("Jan. 2006")
-	if language == "en" {
-		return dateFmt
+	if dt.IsZero() {
+		return "", fmt.Errorf("invalid datetime %q: zero date", datetime)
 	}
-	month := getMonthThai(date.Month())
-	year := date.Year()
 
-	return fmt.Sprintf("%s %d", month, year)
+	dt = time.Date(
+		dt.Year()+year,
+		dt.Month()+time.Month(month),
+		dt.Day()+day,
+		dt.Hour()+hour,
+		dt.Minute()+min,
+		dt.Second()+sec,
+		dt.Nanosecond(),
+		dt.Location(),
+	)
+	return dt.Format(datetimeLayout), nil
 }
 
 // ShortYearMonth short month
-// time.Tim TO Feb. 2025 , กุมภาพันธ์ 2025
-func ShortMonth(date time.Time, language string) string {
-	if !allowedLanguages[language] {
-		return "invalid language"
+// time.Time TO Feb. 2025 , ก.พ. 2025
+func ShortYearMonth(date time.Time, language string) string {
+	s, err := FormatShortYearMonth(date, language)
+	if err != nil {
+		return ErrInvalidLanguage.Error()
 	}
-	dateFmt := date.Format("Jan.")
-	if language == "en" {
-		return dateFmt
+	return s
+}
+
+// FormatShortYearMonth เหมือน ShortYearMonth แต่คืน error เมื่อไม่พบภาษา
+func FormatShortYearMonth(date time.Time, language string) (string, error) {
+	return formatLocaleLayout(date, language, func(l Locale) string { return l.ShortYearMonthLayout })
+}
+
+// ShortMonth short month
+// time.Time TO Feb. , ก.พ.
+func ShortMonth(date time.Time, language string) string {
+	s, err := FormatShortMonth(date, language)
+	if err != nil {
+		return ErrInvalidLanguage.Error()
 	}
-	month := getMonthThai(date.Month())
+	return s
+}
 
-	return month
+// FormatShortMonth เหมือน ShortMonth แต่คืน error เมื่อไม่พบภาษา
+func FormatShortMonth(date time.Time, language string) (string, error) {
+	return formatLocaleLayout(date, language, func(l Locale) string { return l.ShortMonthLayout })
+}
+
+// LongDate วันที่แบบเต็ม เช่น 21 February 2025 หรือ 21 กุมภาพันธ์ 2568 ตาม LongDateLayout ของภาษา
+func LongDate(date time.Time, language string) (string, error) {
+	return formatLocaleLayout(date, language, func(l Locale) string { return l.LongDateLayout })
+}
+
+// จัดรูปแบบวันที่ด้วย layout ที่เลือกจากข้อมูลภาษา
+func formatLocaleLayout(date time.Time, language string, layout func(Locale) string) (string, error) {
+	l, err := lookupLocale(language)
+	if err != nil {
+		return "", err
+	}
+	return FormatThai(date, layout(l), language)
 }
 
 // GetDate get date from string datetime format
@@ -242,6 +195,21 @@ func GetDate(date string) string {
 	return date
 }
 
+// ToDateString คืนเฉพาะวันที่ ("2006-01-02") ตามโซนเวลาของแพ็กเกจ จากข้อความทุกรูปแบบที่ ParseDateTime รองรับ
+// วันที่ว่าง ("0000-00-00" หรือ "0001-01-01T00:00:00Z") จะคืนข้อความว่าง
+func ToDateString(date string) (string, error) {
+	t, err := ParseDateTime(date)
+	if err != nil || t.IsZero() {
+		return "", err
+	}
+	return t.Format(dateLayout), nil
+
+	/*
+		Ex.
+		ToDateString("2025-02-03T20:15:30Z") // "2025-02-04" (เวลาไทย 03:15:30 ของวันถัดไป)
+	*/
+}
+
 // time.Time คืนค่าเฉพาะเวลา
 func DateTimeToTime(datetime string) string {
 	if datetime != "" {
@@ -270,6 +238,16 @@ func DateTimeToTime(datetime string) string {
 	return datetime
 }
 
+// ToTimeString คืนเฉพาะเวลา ("15:04:05") ตามโซนเวลาของแพ็กเกจ จากข้อความทุกรูปแบบที่ ParseDateTime รองรับ
+// ต่างจาก DateTimeToTime ตรงที่แปลงโซนเวลาให้ เช่น "2025-02-03T10:15:30Z" จะได้ "17:15:30"
+func ToTimeString(datetime string) (string, error) {
+	t, err := ParseDateTime(datetime)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(timeLayout), nil
+}
+
 // แปลง format วันที่ 2025-02-03T10:15:30Z TO 2025-02-03 10:15:3
 func FormatISOToDatetime(datetime string) string {
 	if datetime != "" {
@@ -308,6 +286,23 @@ func FormatISOToDatetime(datetime string) string {
 	*/
 }
 
+// ToDatetimeString แปลงข้อความวันเวลาทุกรูปแบบที่ ParseDateTime รองรับเป็น "2006-01-02 15:04:05" ตามโซนเวลาของแพ็กเกจ
+// วันที่ว่างจะคืน "0000-00-00 00:00:00" ตามแบบ MySQL
+func ToDatetimeString(datetime string) (string, error) {
+	t, err := ParseDateTime(datetime)
+	if err != nil {
+		return "", err
+	}
+	return NewTimeTime(t).String(), nil
+
+	/*
+		Ex.
+		ToDatetimeString("2025-02-03T10:15:30Z")          // "2025-02-03 17:15:30"
+		ToDatetimeString("2025-02-03T10:15:30.5+07:00")   // "2025-02-03 10:15:30"
+		ToDatetimeString("0001-01-01T00:00:00Z")          // "0000-00-00 00:00:00"
+	*/
+}
+
 // ดึงข้อมูล วันที่ตามตำแหน่งที่กำหนด
 func FormatDateTimeByPosition(dateTime, position string) string {
 	if dateTime != "" {
@@ -345,14 +340,9 @@ func FormatDateTimeByPosition(dateTime, position string) string {
 }
 
 // คำนวณจำนวนวันระหว่างวันที่สอง (ระหว่าง a และ b)
+// ใช้วันที่ตามโซนเวลาของแต่ละค่า ไม่สนใจเวลาของวัน และติดลบถ้า b อยู่ก่อน a
 func DaysBetween(a, b time.Time) int {
-	days := -a.YearDay()
-	for year := a.Year(); year < b.Year(); year++ {
-		days += time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
-	}
-	days += b.YearDay()
-
-	return days
+	return CivilDateOf(b).DaysSince(CivilDateOf(a))
 
 	/*
 		Ex.
@@ -365,17 +355,29 @@ func DaysBetween(a, b time.Time) int {
 // ถ้าแปลงสำเร็จ จะคืนค่าผลลัพธ์เป็นเวลา
 // ในกรณีที่แปลงไม่สำเร็จ (เกิดข้อผิดพลาด) จะไม่คืนค่าผลลัพธ์ที่ถูกต้องเพราะเราละเว้นการจัดการข้อผิดพลาด
 // dateLayout คืนค่าเฉพาะวันที่
+// ใช้ ParseDate หากต้องการรองรับรูปแบบอื่นและได้ error เมื่อแปลงไม่ได้
 func Date(s string) time.Time {
 	d, _ := time.Parse(dateLayout, s)
 	return d
 }
 
+// ParseDate แปลงข้อความเป็นวันที่เหมือน Date แต่รับข้อความทุกรูปแบบที่ ParseDateTime รองรับ และคืน error ถ้าแปลงไม่ได้
+// ผลลัพธ์เป็นเวลา 00:00:00 ของวันนั้นตามโซนเวลาของแพ็กเกจ
+func ParseDate(s string) (time.Time, error) {
+	t, err := ParseDateTime(s)
+	if err != nil || t.IsZero() {
+		return t, err
+	}
+	return StartOfDay(t), nil
+}
+
 // ตรวจสอบว่าเวลา check อยู่ในช่วงระหว่าง start และ end หรือไม่
 func InTimeSpan(start, end, check time.Time) bool {
 	return (check.After(start) && check.Before(end)) || (check.Equal(start) || check.Equal(end))
 }
 
 // datetimeLayout คืนค่า วันที่และเวลา
+// ใช้ ParseDateTime หากต้องการรองรับรูปแบบอื่นและได้ error เมื่อแปลงไม่ได้
 func DateTime(s string) time.Time {
 	d, _ := time.Parse(datetimeLayout, s)
 	return d
@@ -416,8 +418,8 @@ func ToThaiMonth(month int) (string, error) {
 // วันเวลา ปัจจุบัน ประเทศไทย แบบ string
 func DateTimeNow() string {
 	//location := time.FixedZone("Asia/Bangkok", 7*60*60) // 7 ชั่วโมง
-	location := loadLocation()             //ตั้งโซนเวลาของประเทศไทย (Asia/Bangkok)
-	currentTime := time.Now().In(location) // ใช้ Time Zone ที่กำหนด
+	location := loadLocation()        //ตั้งโซนเวลาของประเทศไทย (Asia/Bangkok)
+	currentTime := now().In(location) // ใช้ Time Zone ที่กำหนด
 	formattedTime := currentTime.Format(datetimeLayout)
 	return formattedTime
 }
@@ -434,32 +436,139 @@ func TimeTimeNow() time.Time {
 }
 
 // Struct หลักที่เก็บเวลา
+// ใช้เป็น field ใน struct ของ API และฐานข้อมูลได้โดยตรง
+// JSON และฐานข้อมูลใช้รูปแบบ "2006-01-02 15:04:05" ตามโซนเวลาไทย ค่าว่าง (IsZero) จะแทนด้วย null
 type TimeTime struct {
 	time time.Time
 }
 
 // ฟังก์ชันหลักสำหรับดึงวันเวลาปัจจุบัน
 func TimeNow() *TimeTime {
-	dateFormat := datetimeLayout
-	tt := DateTimeNow()
-	parsedTime, err := time.Parse(dateFormat, tt)
+	return &TimeTime{time: now().In(loadLocation()).Truncate(time.Second)}
+}
+
+// NewTimeTime สร้าง TimeTime จาก time.Time
+func NewTimeTime(t time.Time) TimeTime {
+	return TimeTime{time: t}
+}
+
+// ParseTimeTime สร้าง TimeTime จากข้อความ "2006-01-02 15:04:05", "2006-01-02" หรือ RFC3339
+// ข้อความที่ไม่มีโซนเวลาจะถือเป็นเวลาไทย ข้อความว่างและ "0000-00-00" ของ MySQL จะได้ค่าว่าง
+func ParseTimeTime(s string) (TimeTime, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.HasPrefix(s, mysqlZeroDate) {
+		return TimeTime{}, nil
+	}
+	for _, layout := range []string{datetimeLayout, dateLayout} {
+		if t, err := time.ParseInLocation(layout, s, loadLocation()); err == nil {
+			return TimeTime{time: t}, nil
+		}
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
 	if err != nil {
-		return &TimeTime{time.Time{}}
+		return TimeTime{}, fmt.Errorf("invalid datetime %q", s)
 	}
-	return &TimeTime{time: parsedTime}
+	return TimeTime{time: t}, nil
+}
+
+// Time คืนค่า time.Time ตามโซนเวลาไทย
+func (d TimeTime) Time() time.Time {
+	return d.local()
+}
+
+// IsZero ตรวจสอบว่าเป็นค่าว่างหรือไม่
+func (d TimeTime) IsZero() bool {
+	return d.time.IsZero()
 }
 
 // เมธอดสำหรับดึงเฉพาะวันที่
-func (d *TimeTime) DateOnly() string {
-	return d.time.Format(dateLayout)
+func (d TimeTime) DateOnly() string {
+	return d.local().Format(dateLayout)
 }
 
 // เมธอดสำหรับดึงเฉพาะเวลา
-func (d *TimeTime) TimeOnly() string {
-	return d.time.Format(timeLayout)
+func (d TimeTime) TimeOnly() string {
+	return d.local().Format(timeLayout)
 }
 
 // เมธอดสำหรับดึงวันเวลาเต็มรูปแบบ
-func (d *TimeTime) DateTime() string {
-	return d.time.Format(datetimeLayout)
+func (d TimeTime) DateTime() string {
+	return d.local().Format(datetimeLayout)
+}
+
+// String คืนวันเวลาเต็มรูปแบบ ค่าว่างจะคืน "0000-00-00 00:00:00" ตามแบบ MySQL
+func (d TimeTime) String() string {
+	if d.IsZero() {
+		return mysqlZeroDate + " 00:00:00"
+	}
+	return d.DateTime()
+}
+
+// MarshalJSON แปลงเป็น JSON ในรูปแบบ "2006-01-02 15:04:05" ค่าว่างจะเป็น null
+func (d TimeTime) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.Quote(d.DateTime())), nil
+}
+
+// UnmarshalJSON อ่านค่าจาก JSON (null, "" และ "0000-00-00 00:00:00" จะได้ค่าว่าง)
+func (d *TimeTime) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*d = TimeTime{}
+		return nil
+	}
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return fmt.Errorf("invalid datetime %s", b)
+	}
+	t, err := ParseTimeTime(s)
+	if err != nil {
+		return err
+	}
+	*d = t
+	return nil
+}
+
+// Value ใช้บันทึกลงฐานข้อมูล (driver.Valuer) ค่าว่างจะบันทึกเป็น NULL
+func (d TimeTime) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.local(), nil
+}
+
+// Scan ใช้อ่านค่าจากฐานข้อมูล (sql.Scanner) รองรับ NULL, time.Time, string และ []byte
+func (d *TimeTime) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = TimeTime{}
+		return nil
+	case time.Time:
+		*d = TimeTime{time: v}
+		return nil
+	case string:
+		t, err := ParseTimeTime(v)
+		if err != nil {
+			return err
+		}
+		*d = t
+		return nil
+	case []byte:
+		t, err := ParseTimeTime(string(v))
+		if err != nil {
+			return err
+		}
+		*d = t
+		return nil
+	}
+	return fmt.Errorf("cannot scan %T into TimeTime", src)
+}
+
+// เวลาตามโซนเวลาไทย (ค่าว่างคืนค่าเดิมเพื่อไม่ให้เวลาเพี้ยนจาก LMT)
+func (d TimeTime) local() time.Time {
+	if d.time.IsZero() {
+		return d.time
+	}
+	return d.time.In(loadLocation())
 }
@@ -0,0 +1,193 @@
+package aider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ชื่อเดือนภาษาไทยแบบเต็ม
+var thaiMonthsFull = []string{
+	"มกราคม", "กุมภาพันธ์", "มีนาคม", "เมษายน", "พฤษภาคม", "มิถุนายน",
+	"กรกฎาคม", "สิงหาคม", "กันยายน", "ตุลาคม", "พฤศจิกายน", "ธันวาคม",
+}
+
+// ชื่อวันภาษาไทยแบบเต็ม เรียงตาม time.Weekday (Sunday = 0)
+var thaiWeekdays = []string{
+	"อาทิตย์", "จันทร์", "อังคาร", "พุธ", "พฤหัสบดี", "ศุกร์", "เสาร์",
+}
+
+// ชื่อวันภาษาไทยแบบย่อ เรียงตาม time.Weekday (Sunday = 0)
+var thaiWeekdaysShort = []string{
+	"อา.", "จ.", "อ.", "พ.", "พฤ.", "ศ.", "ส.",
+}
+
+// ตัวเลขไทย ๐-๙
+var thaiDigits = []rune{'๐', '๑', '๒', '๓', '๔', '๕', '๖', '๗', '๘', '๙'}
+
+// ผลต่างระหว่างปี พ.ศ. กับ ค.ศ.
+const buddhistEraOffset = 543
+
+// token ที่ FormatThai รองรับ เรียงจากยาวไปสั้นเพื่อให้จับคู่ตัวที่ยาวที่สุดก่อน
+var thaiLayoutTokens = []string{
+	"BBBB", "EEEE", "YYYY", "MMMM", "dddd",
+	"MMM", "ddd",
+	"BB", "EE", "YY", "MM", "DD", "HH", "mm", "ss",
+	"M", "D",
+}
+
+// FormatThai จัดรูปแบบวันที่ตาม layout ที่กำหนด ตามภาษาที่ลงทะเบียนไว้ (th, en หรือที่เพิ่มด้วย RegisterLocale)
+// เวลาจะถูกแสดงตามโซนเวลาของ t (เหมือน time.Format) หากต้องการเวลาไทยให้แปลงด้วย t.In(...) ก่อน
+//
+// token ที่รองรับ
+//
+//	BBBB ปี พ.ศ. 4 หลัก (2568)     BB ปี พ.ศ. 2 หลัก (68)
+//	YYYY ปี ค.ศ. 4 หลัก (2025)     YY ปี ค.ศ. 2 หลัก (25)
+//	EEEE ปีตามศักราชของภาษา (th = พ.ศ., en = ค.ศ.)   EE ปีตามศักราชของภาษา 2 หลัก
+//	MMMM ชื่อเดือนเต็ม (กุมภาพันธ์ / February)
+//	MMM  ชื่อเดือนย่อ (ก.พ. / Feb)
+//	MM   เดือน 2 หลัก (02)           M เดือน (2)
+//	DD   วันที่ 2 หลัก (07)          D วันที่ (7)
+//	dddd ชื่อวันเต็ม (ศุกร์ / Friday)  ddd ชื่อวันย่อ (ศ. / Fri)
+//	HH   ชั่วโมง 00-23   mm นาที   ss วินาที
+//
+// ข้อความที่อยู่ใน [ ] จะถูกแสดงตามเดิมโดยไม่แปลงเป็น token
+// คำภาษาอังกฤษที่ไม่ได้ประกอบด้วย token ทั้งคำถือเป็นข้อความธรรมดา (เช่น "Mon", "Date", "Comment")
+// ส่วน token ที่เขียนติดกันอย่าง "YYYYMMDD" ยังแปลงได้ตามปกติ
+func FormatThai(t time.Time, layout, language string) (string, error) {
+	locale, err := lookupLocale(language)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(layout); {
+		// ข้อความใน [ ] แสดงตามเดิม
+		if layout[i] == '[' {
+			end := strings.IndexByte(layout[i+1:], ']')
+			if end < 0 {
+				sb.WriteString(layout[i+1:])
+				break
+			}
+			sb.WriteString(layout[i+1 : i+1+end])
+			i += end + 2
+			continue
+		}
+
+		if !isASCIILetter(layout[i]) {
+			sb.WriteByte(layout[i])
+			i++
+			continue
+		}
+		j := i
+		for j < len(layout) && isASCIILetter(layout[j]) {
+			j++
+		}
+		tokens, ok := splitLayoutTokens(layout[i:j])
+		if !ok {
+			sb.WriteString(layout[i:j])
+		}
+		for _, token := range tokens {
+			sb.WriteString(formatLayoutToken(t, token, locale))
+		}
+		i = j
+	}
+
+	return locale.localizeDigits(sb.String()), nil
+
+	/*
+		Ex.
+		t := time.Date(2025, 2, 21, 14, 30, 0, 0, loadLocation())
+		FormatThai(t, "[วัน]dddd[ที่] D MMMM BBBB", "th") // วันศุกร์ที่ 21 กุมภาพันธ์ 2568
+		FormatThai(t, "DD MMM BB HH:mm", "th")              // 21 ก.พ. 68 14:30
+		FormatThai(t, "ddd, D MMM YYYY", "en")              // Fri, 21 Feb 2025
+	*/
+}
+
+// FormatThaiDigits เหมือน FormatThai แต่แสดงผลตัวเลขเป็นเลขไทย
+func FormatThaiDigits(t time.Time, layout, language string) (string, error) {
+	s, err := FormatThai(t, layout, language)
+	if err != nil {
+		return "", err
+	}
+	return ToThaiDigits(s), nil
+}
+
+// ToThaiDigits แปลงเลขอารบิก 0-9 ในข้อความเป็นเลขไทย ๐-๙
+func ToThaiDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return thaiDigits[r-'0']
+		}
+		return r
+	}, s)
+}
+
+// splitLayoutTokens แยกคำ word (ตัวอักษรอังกฤษที่อยู่ติดกัน) เป็น token
+// คืน false ถ้ามีส่วนใดของคำที่ไม่ใช่ token เพื่อให้คำอย่าง "Comment" หรือ "Class" แสดงตามเดิม
+func splitLayoutTokens(word string) ([]string, bool) {
+	var tokens []string
+	for word != "" {
+		token := matchLayoutToken(word)
+		if token == "" {
+			return nil, false
+		}
+		tokens = append(tokens, token)
+		word = word[len(token):]
+	}
+	return tokens, true
+}
+
+func matchLayoutToken(s string) string {
+	for _, token := range thaiLayoutTokens {
+		if strings.HasPrefix(s, token) {
+			return token
+		}
+	}
+	return ""
+}
+
+func isASCIILetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func formatLayoutToken(t time.Time, token string, l Locale) string {
+	switch token {
+	case "BBBB":
+		return strconv.Itoa(t.Year() + buddhistEraOffset)
+	case "BB":
+		return fmt.Sprintf("%02d", (t.Year()+buddhistEraOffset)%100)
+	case "EEEE":
+		return strconv.Itoa(t.Year() + l.EraOffset)
+	case "EE":
+		return fmt.Sprintf("%02d", (t.Year()+l.EraOffset)%100)
+	case "YYYY":
+		return strconv.Itoa(t.Year())
+	case "YY":
+		return fmt.Sprintf("%02d", t.Year()%100)
+	case "MMMM":
+		return l.Months[t.Month()-1]
+	case "MMM":
+		return l.MonthsShort[t.Month()-1]
+	case "MM":
+		return fmt.Sprintf("%02d", int(t.Month()))
+	case "M":
+		return strconv.Itoa(int(t.Month()))
+	case "DD":
+		return fmt.Sprintf("%02d", t.Day())
+	case "D":
+		return strconv.Itoa(t.Day())
+	case "dddd":
+		return l.Weekdays[t.Weekday()]
+	case "ddd":
+		return l.WeekdaysShort[t.Weekday()]
+	case "HH":
+		return fmt.Sprintf("%02d", t.Hour())
+	case "mm":
+		return fmt.Sprintf("%02d", t.Minute())
+	case "ss":
+		return fmt.Sprintf("%02d", t.Second())
+	}
+	return token
+}
@@ -0,0 +1,114 @@
+package aider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatThai(t *testing.T) {
+	date := time.Date(2025, time.February, 7, 14, 30, 5, 0, time.UTC)
+	type args struct {
+		layout   string
+		language string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "เดือนเต็ม ปี พ.ศ.",
+			args: args{layout: "D MMMM BBBB", language: "th"},
+			want: "7 กุมภาพันธ์ 2568",
+		},
+		{
+			name: "เดือนย่อ ปี พ.ศ. 2 หลัก พร้อมเวลา",
+			args: args{layout: "DD MMM BB HH:mm:ss", language: "th"},
+			want: "07 ก.พ. 68 14:30:05",
+		},
+		{
+			name: "ชื่อวันและข้อความคงที่",
+			args: args{layout: "[วัน]dddd[ที่] D MMMM BBBB", language: "th"},
+			want: "วันศุกร์ที่ 7 กุมภาพันธ์ 2568",
+		},
+		{
+			name: "ชื่อวันย่อภาษาไทย",
+			args: args{layout: "ddd D/M/BB", language: "th"},
+			want: "ศ. 7/2/68",
+		},
+		{
+			name: "ภาษาอังกฤษ ปี ค.ศ.",
+			args: args{layout: "ddd, DD MMM YYYY", language: "en"},
+			want: "Fri, 07 Feb 2025",
+		},
+		{
+			name: "ภาษาอังกฤษ เดือนเต็ม ปี ค.ศ. 2 หลัก",
+			args: args{layout: "dddd MMMM D, YY", language: "en"},
+			want: "Friday February 7, 25",
+		},
+		{
+			name: "M และ D ที่เป็นส่วนหนึ่งของคำแสดงตามเดิม",
+			args: args{layout: "Mon Date: D/M", language: "en"},
+			want: "Mon Date: 7/2",
+		},
+		{
+			name: "token ภายในคำแสดงตามเดิม",
+			args: args{layout: "Comment: DD, Class ss, Summary mm, Days YY", language: "en"},
+			want: "Comment: 07, Class 05, Summary 30, Days 25",
+		},
+		{
+			name: "token เขียนติดกัน",
+			args: args{layout: "YYYYMMDD", language: "en"},
+			want: "20250207",
+		},
+		{
+			name:    "ภาษาไม่ถูกต้อง",
+			args:    args{layout: "D MMMM BBBB", language: "jp"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatThai(date, tt.args.layout, tt.args.language)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FormatThai() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FormatThai() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatThaiDigits(t *testing.T) {
+	date := time.Date(2025, time.February, 21, 0, 0, 0, 0, time.UTC)
+	got, err := FormatThaiDigits(date, "DD/MM/BBBB", "th")
+	if err != nil {
+		t.Fatalf("FormatThaiDigits() error = %v", err)
+	}
+	if want := "๒๑/๐๒/๒๕๖๘"; got != want {
+		t.Errorf("FormatThaiDigits() = %v, want %v", got, want)
+	}
+}
+
+func TestShortDate(t *testing.T) {
+	date := time.Date(2025, time.February, 21, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		language string
+		want     string
+	}{
+		{name: "ภาษาไทย", language: "th", want: "21 ก.พ. 2568"},
+		{name: "ภาษาอังกฤษ", language: "en", want: "21 Feb. 2025"},
+		{name: "ภาษาไม่ถูกต้อง", language: "jp", want: "invalid language"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShortDate(date, tt.language); got != tt.want {
+				t.Errorf("ShortDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,345 @@
+package aider
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ปฏิทินจันทรคติไทยคำนวณตามคัมภีร์สุริยยาตร์ (แบบที่ใช้ในปฏิทินหลวง)
+// ปีจันทรคติเริ่มที่ขึ้น 1 ค่ำ เดือนอ้าย (ประมาณปลายพฤศจิกายน-ธันวาคมของปีก่อน)
+// เดือนคี่มี 29 วัน (ข้างแรม 14 ค่ำ) เดือนคู่มี 30 วัน (ข้างแรม 15 ค่ำ)
+// ปีอธิกวารเดือน 7 มี 30 วัน และปีอธิกมาสมีเดือน 8 สองหน (เดือน 8 และเดือน 8 หลัง)
+// ผลการคำนวณตรวจสอบกับวันหยุดตามประกาศของทางราชการปี 2548-2569 (ค.ศ. 2005-2026)
+// ปีนอกช่วงนี้ใช้กฎเดียวกันแต่ยังไม่ได้ตรวจสอบกับประกาศจริง
+
+// LunarYearType ชนิดของปีจันทรคติ
+type LunarYearType int
+
+const (
+	LunarYearNormal     LunarYearType = iota // ปกติมาส ปกติวาร 354 วัน
+	LunarYearExtraDay                        // อธิกวาร 355 วัน (เดือน 7 มี 30 วัน)
+	LunarYearExtraMonth                      // อธิกมาส 384 วัน (มีเดือน 8 สองหน)
+)
+
+// LunarDate วันที่ตามปฏิทินจันทรคติไทย
+type LunarDate struct {
+	Year     int           // ปีจันทรคติ (พ.ศ.) นับตามปีที่มีเดือน 5-12 ของปีนั้น
+	Month    int           // เดือน 1-12 (1 = เดือนอ้าย, 2 = เดือนยี่)
+	Leap     bool          // เป็นเดือน 8 หลังของปีอธิกมาส
+	Waxing   bool          // true = ข้างขึ้น, false = ข้างแรม
+	Day      int           // ค่ำ (ข้างขึ้น 1-15, ข้างแรม 1-14 หรือ 1-15)
+	YearType LunarYearType // ชนิดของปีจันทรคติ
+}
+
+// จุดอ้างอิง: ขึ้น 1 ค่ำ เดือนอ้าย ของปีจันทรคติ 2568 ตรงกับ 1 ธันวาคม 2567
+const lunarAnchorYear = 2025
+
+var lunarAnchorStart = CivilDate{2024, time.December, 1}
+
+// ผลต่างระหว่างปี พ.ศ. กับจุลศักราช
+const chulaSakaratOffset = 1181
+
+var thaiZodiacs = []string{
+	"ชวด", "ฉลู", "ขาล", "เถาะ", "มะโรง", "มะเส็ง",
+	"มะเมีย", "มะแม", "วอก", "ระกา", "จอ", "กุน",
+}
+
+// LunarDateOf แปลงวันที่ของ t (ตามโซนเวลาของแพ็กเกจ) เป็นวันที่ทางจันทรคติ
+func LunarDateOf(t time.Time) LunarDate {
+	return lunarDateOfCivil(CivilDateOf(t.In(loadLocation())))
+
+	/*
+		Ex.
+		d := LunarDateOf(time.Date(2025, 4, 15, 0, 0, 0, 0, loadLocation()))
+		d.String()    // วันแรม ๓ ค่ำ เดือน ๕ ปีมะเส็ง
+		d.IsHolyDay() // false
+	*/
+}
+
+// String แสดงวันที่ทางจันทรคติ เช่น "วันแรม ๓ ค่ำ เดือน ๕ ปีมะเส็ง"
+func (d LunarDate) String() string {
+	phase := "แรม"
+	if d.Waxing {
+		phase = "ขึ้น"
+	}
+	return fmt.Sprintf("วัน%s %s ค่ำ %s ปี%s", phase, ToThaiDigits(fmt.Sprint(d.Day)), d.MonthName(), d.Zodiac())
+}
+
+// MonthName ชื่อเดือนทางจันทรคติ เช่น "เดือนอ้าย", "เดือนยี่", "เดือน ๕" หรือ "เดือน ๘๘" (เดือน 8 หลัง)
+func (d LunarDate) MonthName() string {
+	switch {
+	case d.Leap:
+		return "เดือน ๘๘"
+	case d.Month == 1:
+		return "เดือนอ้าย"
+	case d.Month == 2:
+		return "เดือนยี่"
+	}
+	return "เดือน " + ToThaiDigits(fmt.Sprint(d.Month))
+}
+
+// Zodiac ปีนักษัตร (ชวด ฉลู ...) โดยเปลี่ยนปีนักษัตรที่ขึ้น 1 ค่ำ เดือน 5
+func (d LunarDate) Zodiac() string {
+	year := d.Year - buddhistEraOffset
+	if d.Month < 5 {
+		year--
+	}
+	return thaiZodiacs[((year-4)%12+12)%12]
+}
+
+// IsHolyDay ตรวจสอบว่าเป็นวันพระหรือไม่ (ขึ้น 8 ค่ำ, ขึ้น 15 ค่ำ, แรม 8 ค่ำ และแรม 14 หรือ 15 ค่ำที่เป็นวันสิ้นเดือน)
+func (d LunarDate) IsHolyDay() bool {
+	if d.Day == 8 {
+		return true
+	}
+	if d.Waxing {
+		return d.Day == 15
+	}
+	return d.Day == lunarMonthDays(d.Month, d.YearType)-15
+}
+
+// BuddhistHolyDays คืนรายการวันพระทั้งหมดในช่วงวันที่ r
+func BuddhistHolyDays(r DateRange) []CivilDate {
+	var result []CivilDate
+	if r.IsEmpty() {
+		return result
+	}
+	// คำนวณวันเริ่มปีจันทรคติครั้งเดียวต่อปี แล้วไล่ตามเดือนไปจนสิ้นช่วง
+	year := lunarDateOfCivil(r.Start).Year - buddhistEraOffset
+	for start := lunarYearStart(year); !start.After(r.End); year++ {
+		yt := lunarYearType(year)
+		for _, m := range lunarMonths(yt) {
+			for _, day := range []int{8, 15, 23, m.days} {
+				if d := start.AddDays(day - 1); r.Contains(d) {
+					result = append(result, d)
+				}
+			}
+			start = start.AddDays(m.days)
+		}
+	}
+	return result
+}
+
+// MakhaBucha วันมาฆบูชาของปี ค.ศ. year (ขึ้น 15 ค่ำ เดือน 3 หรือเดือน 4 ในปีอธิกมาส)
+func MakhaBucha(year int) CivilDate {
+	if lunarYearType(year) == LunarYearExtraMonth {
+		return lunarCivilDate(year, 4, false, true, 15)
+	}
+	return lunarCivilDate(year, 3, false, true, 15)
+}
+
+// VisakhaBucha วันวิสาขบูชาของปี ค.ศ. year (ขึ้น 15 ค่ำ เดือน 6 หรือเดือน 7 ในปีอธิกมาส)
+func VisakhaBucha(year int) CivilDate {
+	if lunarYearType(year) == LunarYearExtraMonth {
+		return lunarCivilDate(year, 7, false, true, 15)
+	}
+	return lunarCivilDate(year, 6, false, true, 15)
+}
+
+// AsarnhaBucha วันอาสาฬหบูชาของปี ค.ศ. year (ขึ้น 15 ค่ำ เดือน 8 หรือเดือน 8 หลังในปีอธิกมาส)
+func AsarnhaBucha(year int) CivilDate {
+	return lunarCivilDate(year, 8, lunarYearType(year) == LunarYearExtraMonth, true, 15)
+}
+
+// KhaoPhansa วันเข้าพรรษาของปี ค.ศ. year (แรม 1 ค่ำ หลังวันอาสาฬหบูชา)
+func KhaoPhansa(year int) CivilDate {
+	return AsarnhaBucha(year).AddDays(1)
+}
+
+// ThaiLunarHolidays วันหยุดราชการทางจันทรคติของปี ค.ศ. year
+// ใช้เพิ่มเข้า HolidayCalendar ได้ด้วย Add
+func ThaiLunarHolidays(year int) []Holiday {
+	loc := loadLocation()
+	return []Holiday{
+		{Date: MakhaBucha(year).In(loc), Name: "วันมาฆบูชา"},
+		{Date: VisakhaBucha(year).In(loc), Name: "วันวิสาขบูชา"},
+		{Date: AsarnhaBucha(year).In(loc), Name: "วันอาสาฬหบูชา"},
+		{Date: KhaoPhansa(year).In(loc), Name: "วันเข้าพรรษา"},
+	}
+
+	/*
+		Ex.
+		cal := NewThaiHolidayCalendar()
+		for _, h := range ThaiLunarHolidays(2025) {
+			cal.Add(h.Date, h.Name)
+		}
+	*/
+}
+
+// ค่าจากคัมภีร์สุริยยาตร์ของจุลศักราช cs: กัมมัชพล, อวมาน และดิถีวันเถลิงศก
+func suriyayatra(cs int) (kammacubala, avoman, tithi int) {
+	total := 292207*cs + 373
+	horakhun := total/800 + 1
+	kammacubala = 800 - total%800
+	avoman = (11*horakhun + 650) % 692
+	tithi = (horakhun + (11*horakhun+650)/692) % 30
+	return kammacubala, avoman, tithi
+}
+
+// ปีที่ดิถีเข้าเกณฑ์อธิกมาส
+func isAdhikamasCandidate(cs int) bool {
+	_, _, tithi := suriyayatra(cs)
+	return tithi >= 24 || tithi <= 5
+}
+
+// ปีอธิกมาส ถ้าปีนี้และปีถัดไปเข้าเกณฑ์ทั้งคู่ และดิถีปีนี้เป็น 24 หรือ 25 ให้ไปเป็นอธิกมาสปีถัดไป
+func isAdhikamas(cs int) bool {
+	if !isAdhikamasCandidate(cs) {
+		return false
+	}
+	_, _, tithi := suriyayatra(cs)
+	return !((tithi == 24 || tithi == 25) && isAdhikamasCandidate(cs+1))
+}
+
+// ปีที่อวมานเข้าเกณฑ์อธิกวาร (ปีสุรทินใช้เกณฑ์ 126 ปีปกติใช้เกณฑ์ 137)
+func isAdhikavarCandidate(cs int) bool {
+	kammacubala, avoman, _ := suriyayatra(cs)
+	if avoman == 137 {
+		if _, next, _ := suriyayatra(cs + 1); next == 0 {
+			return false
+		}
+	}
+	if kammacubala <= 207 {
+		return avoman <= 126
+	}
+	return avoman <= 137
+}
+
+// ชนิดของปีจันทรคติ ค.ศ. year ปีที่เป็นอธิกมาสไม่เป็นอธิกวาร และให้เลื่อนอธิกวารไปปีถัดไป
+func lunarYearType(year int) LunarYearType {
+	cs := year + buddhistEraOffset - chulaSakaratOffset
+	switch {
+	case isAdhikamas(cs):
+		return LunarYearExtraMonth
+	case isAdhikavarCandidate(cs), isAdhikamas(cs-1) && isAdhikavarCandidate(cs-1):
+		return LunarYearExtraDay
+	}
+	return LunarYearNormal
+}
+
+// จำนวนวันของปีจันทรคติ ค.ศ. year
+func lunarYearDays(year int) int {
+	switch lunarYearType(year) {
+	case LunarYearExtraDay:
+		return 355
+	case LunarYearExtraMonth:
+		return 384
+	}
+	return 354
+}
+
+// จำนวนวันของเดือนจันทรคติ
+func lunarMonthDays(month int, yt LunarYearType) int {
+	switch {
+	case month == 8, month == 7 && yt == LunarYearExtraDay:
+		return 30
+	case month%2 == 1:
+		return 29
+	}
+	return 30
+}
+
+// ช่วงปี ค.ศ. ที่เก็บวันเริ่มปีจันทรคติไว้ล่วงหน้า ปีนอกช่วงจะคำนวณต่อจากขอบของตาราง
+const (
+	lunarTableFirstYear = 1900
+	lunarTableLastYear  = 2200
+)
+
+var (
+	lunarStartsOnce sync.Once
+	lunarStarts     []CivilDate // lunarStarts[i] คือวันเริ่มปีจันทรคติ ค.ศ. lunarTableFirstYear+i
+)
+
+// วันขึ้น 1 ค่ำ เดือนอ้าย ของปีจันทรคติ ค.ศ. year
+func lunarYearStart(year int) CivilDate {
+	lunarStartsOnce.Do(buildLunarStarts)
+	switch {
+	case year < lunarTableFirstYear:
+		return walkLunarYearStart(lunarStarts[0], lunarTableFirstYear, year)
+	case year > lunarTableLastYear:
+		return walkLunarYearStart(lunarStarts[len(lunarStarts)-1], lunarTableLastYear, year)
+	}
+	return lunarStarts[year-lunarTableFirstYear]
+}
+
+func buildLunarStarts() {
+	lunarStarts = make([]CivilDate, lunarTableLastYear-lunarTableFirstYear+1)
+	start := walkLunarYearStart(lunarAnchorStart, lunarAnchorYear, lunarTableFirstYear)
+	for i := range lunarStarts {
+		lunarStarts[i] = start
+		start = start.AddDays(lunarYearDays(lunarTableFirstYear + i))
+	}
+}
+
+// นับวันเริ่มปีจันทรคติของปี year ต่อจากวันเริ่มปี from ที่ทราบแล้ว
+func walkLunarYearStart(start CivilDate, from, year int) CivilDate {
+	for y := from; y < year; y++ {
+		start = start.AddDays(lunarYearDays(y))
+	}
+	for y := from - 1; y >= year; y-- {
+		start = start.AddDays(-lunarYearDays(y))
+	}
+	return start
+}
+
+// เดือนของปีจันทรคติตามลำดับ (รวมเดือน 8 หลังในปีอธิกมาส)
+type lunarMonth struct {
+	month int
+	leap  bool
+	days  int
+}
+
+func lunarMonths(yt LunarYearType) []lunarMonth {
+	months := make([]lunarMonth, 0, 13)
+	for m := 1; m <= 12; m++ {
+		months = append(months, lunarMonth{month: m, days: lunarMonthDays(m, yt)})
+		if m == 8 && yt == LunarYearExtraMonth {
+			months = append(months, lunarMonth{month: m, leap: true, days: 30})
+		}
+	}
+	return months
+}
+
+func lunarDateOfCivil(d CivilDate) LunarDate {
+	// ปีจันทรคติเริ่มก่อนสิ้นปี ค.ศ. เสมอ จึงเริ่มตรวจจากปีถัดไป
+	year := d.Year + 1
+	start := lunarYearStart(year)
+	for d.Before(start) {
+		year--
+		start = start.AddDays(-lunarYearDays(year))
+	}
+
+	yt := lunarYearType(year)
+	offset := d.DaysSince(start)
+	for _, m := range lunarMonths(yt) {
+		if offset >= m.days {
+			offset -= m.days
+			continue
+		}
+		ld := LunarDate{Year: year + buddhistEraOffset, Month: m.month, Leap: m.leap, YearType: yt}
+		if offset < 15 {
+			ld.Waxing, ld.Day = true, offset+1
+		} else {
+			ld.Day = offset - 14
+		}
+		return ld
+	}
+	// ไม่เกิดขึ้น เพราะ offset น้อยกว่าจำนวนวันของปีเสมอ
+	return LunarDate{}
+}
+
+// วันที่ตามปฏิทินสุริยคติของวันทางจันทรคติในปี ค.ศ. year
+func lunarCivilDate(year, month int, leap, waxing bool, day int) CivilDate {
+	d := lunarYearStart(year)
+	for _, m := range lunarMonths(lunarYearType(year)) {
+		if m.month == month && m.leap == leap {
+			break
+		}
+		d = d.AddDays(m.days)
+	}
+	if !waxing {
+		day += 15
+	}
+	return d.AddDays(day - 1)
+}
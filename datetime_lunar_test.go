@@ -0,0 +1,120 @@
+package aider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLunarDateOf(t *testing.T) {
+	loc := loadLocation()
+	tests := []struct {
+		name string
+		date time.Time
+		want string
+	}{
+		{name: "แรม 3 ค่ำ เดือน 5", date: time.Date(2025, 4, 15, 0, 0, 0, 0, loc), want: "วันแรม ๓ ค่ำ เดือน ๕ ปีมะเส็ง"},
+		{name: "วันวิสาขบูชา", date: time.Date(2025, 5, 11, 20, 0, 0, 0, loc), want: "วันขึ้น ๑๕ ค่ำ เดือน ๖ ปีมะเส็ง"},
+		{name: "เดือนอ้ายปีก่อนเปลี่ยนนักษัตร", date: time.Date(2024, 12, 1, 0, 0, 0, 0, loc), want: "วันขึ้น ๑ ค่ำ เดือนอ้าย ปีมะโรง"},
+		{name: "เดือน 8 หลังปีอธิกมาส", date: time.Date(2023, 8, 1, 0, 0, 0, 0, loc), want: "วันขึ้น ๑๕ ค่ำ เดือน ๘๘ ปีเถาะ"},
+		{name: "เวลา UTC ข้ามวัน", date: time.Date(2025, 5, 10, 18, 0, 0, 0, time.UTC), want: "วันขึ้น ๑๕ ค่ำ เดือน ๖ ปีมะเส็ง"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LunarDateOf(tt.date).String(); got != tt.want {
+				t.Errorf("LunarDateOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestThaiLunarHolidays(t *testing.T) {
+	// วันหยุดตามประกาศของทางราชการ
+	tests := []struct {
+		year                    int
+		makha, visakha, asarnha string
+	}{
+		{2005, "2005-02-23", "2005-05-22", "2005-07-21"},
+		{2006, "2006-02-13", "2006-05-12", "2006-07-10"},
+		{2007, "2007-03-03", "2007-05-31", "2007-07-29"},
+		{2008, "2008-02-21", "2008-05-19", "2008-07-17"},
+		{2009, "2009-02-09", "2009-05-08", "2009-07-07"},
+		{2010, "2010-02-28", "2010-05-28", "2010-07-26"},
+		{2011, "2011-02-18", "2011-05-17", "2011-07-15"},
+		{2012, "2012-03-07", "2012-06-04", "2012-08-02"},
+		{2013, "2013-02-25", "2013-05-24", "2013-07-22"},
+		{2014, "2014-02-14", "2014-05-13", "2014-07-11"},
+		{2015, "2015-03-04", "2015-06-01", "2015-07-30"},
+		{2016, "2016-02-22", "2016-05-20", "2016-07-19"},
+		{2017, "2017-02-11", "2017-05-10", "2017-07-08"},
+		{2018, "2018-03-01", "2018-05-29", "2018-07-27"},
+		{2019, "2019-02-19", "2019-05-18", "2019-07-16"},
+		{2020, "2020-02-08", "2020-05-06", "2020-07-05"},
+		{2021, "2021-02-26", "2021-05-26", "2021-07-24"},
+		{2022, "2022-02-16", "2022-05-15", "2022-07-13"},
+		{2023, "2023-03-06", "2023-06-03", "2023-08-01"},
+		{2024, "2024-02-24", "2024-05-22", "2024-07-20"},
+		{2025, "2025-02-12", "2025-05-11", "2025-07-10"},
+		{2026, "2026-03-03", "2026-05-31", "2026-07-29"},
+	}
+	for _, tt := range tests {
+		got := [3]string{MakhaBucha(tt.year).String(), VisakhaBucha(tt.year).String(), AsarnhaBucha(tt.year).String()}
+		if want := [3]string{tt.makha, tt.visakha, tt.asarnha}; got != want {
+			t.Errorf("%d: got %v, want %v", tt.year, got, want)
+		}
+	}
+
+	holidays := ThaiLunarHolidays(2025)
+	if last := holidays[len(holidays)-1]; last.Name != "วันเข้าพรรษา" || last.Date.Format(dateLayout) != "2025-07-11" {
+		t.Errorf("ThaiLunarHolidays() last = %+v", last)
+	}
+}
+
+func TestBuddhistHolyDays(t *testing.T) {
+	// เดือน 6 (30 วัน) และเดือน 7 ของปีอธิกวาร 2568
+	r := NewDateRange(CivilDate{2025, time.April, 28}, CivilDate{2025, time.June, 26})
+	var got []string
+	for _, d := range BuddhistHolyDays(r) {
+		got = append(got, d.String())
+	}
+	want := []string{
+		"2025-05-04", "2025-05-11", "2025-05-19", "2025-05-26",
+		"2025-06-03", "2025-06-10", "2025-06-18", "2025-06-25",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("BuddhistHolyDays() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("BuddhistHolyDays()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestBuddhistHolyDaysMatchesLunarDate(t *testing.T) {
+	// ช่วงที่คร่อมหลายปีจันทรคติ รวมถึงปีนอกตารางวันเริ่มปีที่เก็บไว้ล่วงหน้า
+	for _, r := range []DateRange{
+		NewDateRange(CivilDate{2023, time.October, 1}, CivilDate{2026, time.March, 31}),
+		NewDateRange(CivilDate{1898, time.November, 15}, CivilDate{1900, time.February, 1}),
+		NewDateRange(CivilDate{2200, time.October, 1}, CivilDate{2201, time.June, 1}),
+	} {
+		var want []CivilDate
+		EachCivilDate(r.Start, r.End, func(d CivilDate) bool {
+			if lunarDateOfCivil(d).IsHolyDay() {
+				want = append(want, d)
+			}
+			return true
+		})
+		got := BuddhistHolyDays(r)
+		if len(got) != len(want) {
+			t.Fatalf("BuddhistHolyDays(%v) returned %d days, want %d", r.Start, len(got), len(want))
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("BuddhistHolyDays(%v)[%d] = %v, want %v", r.Start, i, got[i], want[i])
+			}
+		}
+	}
+	if got := BuddhistHolyDays(NewDateRange(CivilDate{2025, time.May, 2}, CivilDate{2025, time.May, 1})); len(got) != 0 {
+		t.Errorf("BuddhistHolyDays(empty) = %v", got)
+	}
+}
@@ -0,0 +1,283 @@
+package aider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// เวลาท้ายข้อความ เช่น 14:30, 14.30 น., 14:30:15
+	thaiTimeSuffix = regexp.MustCompile(`\s+(\d{1,2})[:.](\d{2})(?:[:.](\d{2}))?(?:\s*น\.)?$`)
+	// วันที่แบบตัวเลข เช่น 21/02/2568, 21-2-68
+	thaiNumericDate = regexp.MustCompile(`^(\d{1,2})[/\-.](\d{1,2})[/\-.](\d{2}|\d{4})$`)
+)
+
+// ยุคของปีที่ระบุในข้อความ
+const (
+	eraUnknown = iota
+	eraBE
+	eraCE
+)
+
+// ตารางชื่อเดือน (ตัดจุดและเป็นตัวพิมพ์เล็กแล้ว) -> เดือน
+var monthNames = buildMonthNames()
+
+func buildMonthNames() map[string]time.Month {
+	names := make(map[string]time.Month)
+	for i := 0; i < 12; i++ {
+		month := time.Month(i + 1)
+		names[normalizeMonthName(thaiMonths[i])] = month
+		names[normalizeMonthName(thaiMonthsFull[i])] = month
+		names[normalizeMonthName(month.String())] = month
+		names[normalizeMonthName(month.String()[:3])] = month
+	}
+	names["sept"] = time.September
+	return names
+}
+
+func normalizeMonthName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, ".", ""))
+}
+
+// ปี 4 หลักที่มากกว่าค่านี้ถือเป็นปี พ.ศ. เมื่อไม่ได้ระบุยุค
+const buddhistYearThreshold = 2400
+
+// ParseThai แปลงข้อความวันที่แบบไทยกลับเป็น time.Time ในโซนเวลาไทย (Asia/Bangkok)
+// รองรับชื่อเดือนไทยแบบเต็มและแบบย่อ ชื่อเดือนภาษาอังกฤษ เลขไทย และเวลาต่อท้าย (เช่น 14:30 หรือ 14.30 น.)
+// ปี 4 หลักที่มากกว่า 2400 ถือเป็น พ.ศ. ปี 2 หลักถือเป็น พ.ศ. (ยกเว้นใช้ชื่อเดือนภาษาอังกฤษ)
+// สามารถระบุ "พ.ศ." หรือ "ค.ศ." หน้าปีเพื่อบังคับยุคได้
+func ParseThai(s string) (time.Time, error) {
+	input := s
+	s = strings.Join(strings.Fields(fromThaiDigits(s)), " ")
+	if s == "" {
+		return time.Time{}, fmt.Errorf("invalid thai date %q: empty string", input)
+	}
+
+	// แยกเวลาที่อยู่ท้ายข้อความ
+	var hour, min, sec int
+	if m := thaiTimeSuffix.FindStringSubmatch(s); m != nil {
+		hour, _ = strconv.Atoi(m[1])
+		min, _ = strconv.Atoi(m[2])
+		if m[3] != "" {
+			sec, _ = strconv.Atoi(m[3])
+		}
+		if hour > 23 || min > 59 || sec > 59 {
+			return time.Time{}, fmt.Errorf("invalid thai date %q: time out of range", input)
+		}
+		s = strings.TrimSpace(s[:len(s)-len(m[0])])
+	}
+
+	// ตรวจหาคำระบุยุค
+	era := eraUnknown
+	switch {
+	case strings.Contains(s, "พ.ศ."):
+		era = eraBE
+		s = strings.Join(strings.Fields(strings.Replace(s, "พ.ศ.", " ", 1)), " ")
+	case strings.Contains(s, "ค.ศ."):
+		era = eraCE
+		s = strings.Join(strings.Fields(strings.Replace(s, "ค.ศ.", " ", 1)), " ")
+	}
+
+	var dayStr, yearStr string
+	var month time.Month
+	if m := thaiNumericDate.FindStringSubmatch(s); m != nil {
+		dayStr, yearStr = m[1], m[3]
+		mm, _ := strconv.Atoi(m[2])
+		if mm < 1 || mm > 12 {
+			return time.Time{}, fmt.Errorf("invalid thai date %q: month out of range", input)
+		}
+		month = time.Month(mm)
+	} else {
+		fields := strings.Fields(s)
+		if len(fields) != 3 {
+			return time.Time{}, fmt.Errorf("invalid thai date %q: expected day, month and year", input)
+		}
+		dayStr, yearStr = fields[0], fields[2]
+		name := normalizeMonthName(fields[1])
+		m, ok := monthNames[name]
+		if !ok {
+			return time.Time{}, fmt.Errorf("invalid thai date %q: unknown month %q", input, fields[1])
+		}
+		month = m
+		// ชื่อเดือนภาษาอังกฤษ ถือว่าเป็นปี ค.ศ. หากไม่ได้ระบุยุค
+		if era == eraUnknown && name[0] < 0x80 {
+			era = eraCE
+		}
+	}
+
+	day, err := strconv.Atoi(dayStr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid thai date %q: invalid day %q", input, dayStr)
+	}
+	year, err := resolveThaiYear(yearStr, era)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid thai date %q: %w", input, err)
+	}
+
+	t := time.Date(year, month, day, hour, min, sec, 0, loadLocation())
+	if t.Day() != day || t.Month() != month {
+		return time.Time{}, fmt.Errorf("invalid thai date %q: day out of range", input)
+	}
+	return t, nil
+
+	/*
+		Ex.
+		ParseThai("21 ก.พ. 2568")        // 2025-02-21 00:00:00 +0700
+		ParseThai("21 กุมภาพันธ์ 2568")  // 2025-02-21 00:00:00 +0700
+		ParseThai("21/02/2568 14:30")    // 2025-02-21 14:30:00 +0700
+		ParseThai("๒๑/๐๒/๒๕๖๘")          // 2025-02-21 00:00:00 +0700
+		ParseThai("21 Feb 2025")         // 2025-02-21 00:00:00 +0700
+	*/
+}
+
+// แปลงปีในข้อความเป็นปี ค.ศ.
+func resolveThaiYear(s string, era int) (int, error) {
+	year, err := strconv.Atoi(s)
+	if err != nil || year < 0 {
+		return 0, fmt.Errorf("invalid year %q", s)
+	}
+
+	if len(s) <= 2 {
+		if era == eraCE {
+			return 2000 + year, nil
+		}
+		return 2500 + year - buddhistEraOffset, nil
+	}
+
+	if era == eraBE || (era == eraUnknown && year > buddhistYearThreshold) {
+		return year - buddhistEraOffset, nil
+	}
+	return year, nil
+}
+
+// แปลงเลขไทย ๐-๙ ในข้อความเป็นเลขอารบิก
+func fromThaiDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '๐' && r <= '๙' {
+			return '0' + (r - '๐')
+		}
+		return r
+	}, s)
+}
+
+// รูปแบบที่มีโซนเวลา (ISO 8601 / RFC3339) วินาทีแบบทศนิยมรองรับอยู่แล้วในการแปลงของ Go
+var zonedLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05Z0700",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05 -0700 MST",
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// รูปแบบที่ไม่มีโซนเวลา จะถือเป็นเวลาตามโซนเวลาของแพ็กเกจ
+var localLayouts = []string{
+	datetimeLayout,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	dateLayout,
+	"2006/01/02 15:04:05",
+	"2006/01/02",
+	"20060102",
+	"2006",
+}
+
+// ParseDateTime แปลงข้อความวันเวลาโดยตรวจรูปแบบอัตโนมัติ และคืนค่าในโซนเวลาของแพ็กเกจ (ค่าเริ่มต้น Asia/Bangkok)
+// รองรับ RFC3339/ISO 8601 (โซนเวลาใดก็ได้ และวินาทีแบบทศนิยม), วันเวลาแบบ MySQL, วันที่อย่างเดียว,
+// วันที่แบบตัวเลขติดกัน (20060102) หรือปีอย่างเดียว (2006), Unix timestamp (10 หลักเป็นวินาที 13 หลักเป็นมิลลิวินาที)
+// และรูปแบบไทยตาม ParseThai
+// รูปแบบที่ขึ้นต้นด้วยปี 4 หลักใช้กฎเดียวกับ ParseThai คือปีที่มากกว่า 2400 ถือเป็น พ.ศ. เช่น "2568-02-21" หรือ "25680221"
+// ข้อความที่ไม่มีโซนเวลาถือเป็นเวลาตามโซนเวลาของแพ็กเกจ วันที่ว่างของ MySQL ("0000-00-00") จะได้ time.Time{}
+func ParseDateTime(s string) (time.Time, error) {
+	input := s
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, fmt.Errorf("invalid datetime %q: empty string", input)
+	}
+	if strings.HasPrefix(s, mysqlZeroDate) {
+		return time.Time{}, nil
+	}
+	loc := loadLocation()
+
+	if t, ok, err := parseUnixTimestamp(s); ok {
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid datetime %q: %w", input, err)
+		}
+		return t.In(loc), nil
+	}
+
+	// Go ไม่รับ "t" และ "z" ตัวพิมพ์เล็ก
+	iso := fromBuddhistYearPrefix(s)
+	if len(iso) > 10 && (iso[10] == 't' || iso[10] == 'T') {
+		iso = iso[:10] + "T" + iso[11:]
+	}
+	if strings.HasSuffix(iso, "z") {
+		iso = iso[:len(iso)-1] + "Z"
+	}
+	for _, layout := range zonedLayouts {
+		if t, err := time.Parse(layout, iso); err == nil {
+			if t.IsZero() {
+				return time.Time{}, nil
+			}
+			return t.In(loc), nil
+		}
+	}
+	for _, layout := range localLayouts {
+		if t, err := time.ParseInLocation(layout, iso, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	if t, err := ParseThai(s); err == nil {
+		return t.In(loc), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid datetime %q: unrecognized format", input)
+
+	/*
+		Ex.
+		ParseDateTime("2025-02-21T07:30:00Z")            // 2025-02-21 14:30:00 +0700
+		ParseDateTime("2025-02-21T14:30:00.123+07:00")   // 2025-02-21 14:30:00.123 +0700
+		ParseDateTime("2025-02-21 14:30:00")             // 2025-02-21 14:30:00 +0700
+		ParseDateTime("2025-02-21")                      // 2025-02-21 00:00:00 +0700
+		ParseDateTime("2568-02-21")                      // 2025-02-21 00:00:00 +0700 (ปี พ.ศ.)
+		ParseDateTime("1740123000")                      // 2025-02-21 14:30:00 +0700
+		ParseDateTime("21 ก.พ. 2568 14:30")              // 2025-02-21 14:30:00 +0700
+	*/
+}
+
+// แปลงปี พ.ศ. 4 หลักที่ต้นข้อความเป็น ค.ศ. ก่อนแปลงด้วย layout ของ Go
+// (แปลงที่ข้อความเพื่อให้วันที่ 29 ก.พ. ของปีอธิกสุรทินตาม ค.ศ. ยังแปลงได้)
+func fromBuddhistYearPrefix(s string) string {
+	if len(s) < 4 || strings.TrimLeft(s[:4], "0123456789") != "" {
+		return s
+	}
+	year, _ := strconv.Atoi(s[:4])
+	if year <= buddhistYearThreshold {
+		return s
+	}
+	return strconv.Itoa(year-buddhistEraOffset) + s[4:]
+}
+
+// แปลง Unix timestamp ที่เป็นตัวเลขล้วน 10 หลัก (วินาที) หรือ 13 หลัก (มิลลิวินาที)
+// ok เป็น false ถ้าข้อความไม่ใช่ timestamp เพื่อให้ลองรูปแบบวันที่อื่น เช่น "20250221" หรือ "2025"
+func parseUnixTimestamp(s string) (t time.Time, ok bool, err error) {
+	digits := strings.TrimPrefix(s, "-")
+	if strings.TrimLeft(digits, "0123456789") != "" || (len(digits) != 10 && len(digits) != 13) {
+		return time.Time{}, false, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, true, err
+	}
+	if len(digits) == 10 {
+		return time.Unix(n, 0), true, nil
+	}
+	return time.UnixMilli(n), true, nil
+}
@@ -0,0 +1,91 @@
+package aider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseThai(t *testing.T) {
+	loc := loadLocation()
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "เดือนย่อ ปี พ.ศ.", input: "21 ก.พ. 2568", want: time.Date(2025, 2, 21, 0, 0, 0, 0, loc)},
+		{name: "เดือนเต็ม ปี พ.ศ.", input: "21 กุมภาพันธ์ 2568", want: time.Date(2025, 2, 21, 0, 0, 0, 0, loc)},
+		{name: "เดือนย่อไม่มีจุด", input: "1 มค 2568", want: time.Date(2025, 1, 1, 0, 0, 0, 0, loc)},
+		{name: "ตัวเลข ปี พ.ศ.", input: "21/02/2568", want: time.Date(2025, 2, 21, 0, 0, 0, 0, loc)},
+		{name: "เลขไทย", input: "๒๑/๐๒/๒๕๖๘", want: time.Date(2025, 2, 21, 0, 0, 0, 0, loc)},
+		{name: "ปี ค.ศ.", input: "21/02/2025", want: time.Date(2025, 2, 21, 0, 0, 0, 0, loc)},
+		{name: "ปี พ.ศ. 2 หลัก", input: "21-2-68", want: time.Date(2025, 2, 21, 0, 0, 0, 0, loc)},
+		{name: "ระบุ พ.ศ.", input: "5 ธันวาคม พ.ศ. 2567", want: time.Date(2024, 12, 5, 0, 0, 0, 0, loc)},
+		{name: "พร้อมเวลา", input: "21 ก.พ. 2568 14.30 น.", want: time.Date(2025, 2, 21, 14, 30, 0, 0, loc)},
+		{name: "เดือนภาษาอังกฤษ", input: "21 Feb. 2025 08:15:30", want: time.Date(2025, 2, 21, 8, 15, 30, 0, loc)},
+		{name: "วันที่ไม่มีจริง", input: "30 ก.พ. 2568", wantErr: true},
+		{name: "ชื่อเดือนไม่ถูกต้อง", input: "21 กพพ 2568", wantErr: true},
+		{name: "ข้อความว่าง", input: " ", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseThai(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseThai() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseThai() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+	loc := loadLocation()
+	want := time.Date(2025, 2, 21, 14, 30, 0, 0, loc)
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "RFC3339 UTC", input: "2025-02-21T07:30:00Z", want: want},
+		{name: "RFC3339 z ตัวพิมพ์เล็ก", input: "2025-02-21t07:30:00z", want: want},
+		{name: "โซนเวลาอื่น", input: "2025-02-21T16:30:00+09:00", want: want},
+		{name: "โซนเวลาไม่มีโคลอน", input: "2025-02-21T14:30:00+0700", want: want},
+		{name: "วินาทีแบบทศนิยม", input: "2025-02-21T07:30:00.123456Z", want: want.Add(123456 * time.Microsecond)},
+		{name: "MySQL", input: "2025-02-21 14:30:00", want: want},
+		{name: "MySQL ทศนิยม", input: "2025-02-21 14:30:00.5", want: want.Add(500 * time.Millisecond)},
+		{name: "วันที่อย่างเดียว", input: "2025-02-21", want: time.Date(2025, 2, 21, 0, 0, 0, 0, loc)},
+		{name: "Unix วินาที", input: "1740123000", want: want},
+		{name: "Unix มิลลิวินาที", input: "1740123000250", want: want.Add(250 * time.Millisecond)},
+		{name: "วันที่ตัวเลขติดกัน", input: "20250221", want: time.Date(2025, 2, 21, 0, 0, 0, 0, loc)},
+		{name: "ปีอย่างเดียว", input: "2025", want: time.Date(2025, 1, 1, 0, 0, 0, 0, loc)},
+		{name: "ตัวเลขที่ไม่ใช่ timestamp", input: "12345", wantErr: true},
+		{name: "ปี พ.ศ. แบบ ISO", input: "2568-02-21", want: time.Date(2025, 2, 21, 0, 0, 0, 0, loc)},
+		{name: "ปี พ.ศ. ตัวเลขติดกัน", input: "25680221", want: time.Date(2025, 2, 21, 0, 0, 0, 0, loc)},
+		{name: "ปี พ.ศ. อย่างเดียว", input: "2568", want: time.Date(2025, 1, 1, 0, 0, 0, 0, loc)},
+		{name: "ปี พ.ศ. พร้อมเวลา", input: "2568-02-21 14:30:00", want: want},
+		{name: "29 ก.พ. ปี พ.ศ. อธิกสุรทิน", input: "2567-02-29", want: time.Date(2024, 2, 29, 0, 0, 0, 0, loc)},
+		{name: "รูปแบบไทย", input: "21 ก.พ. 2568 14:30", want: want},
+		{name: "วันที่ว่างของ MySQL", input: "0000-00-00 00:00:00", want: time.Time{}},
+		{name: "รูปแบบไม่รู้จัก", input: "yesterday", wantErr: true},
+		{name: "เวลาไม่ถูกต้อง", input: "2025-02-21 25:00:00", wantErr: true},
+		{name: "ข้อความว่าง", input: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDateTime(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDateTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseDateTime() = %v, want %v", got, tt.want)
+			}
+			if !got.IsZero() && got.Location() != loc {
+				t.Errorf("ParseDateTime() location = %v, want %v", got.Location(), loc)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package aider
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeTimeJSON(t *testing.T) {
+	type payload struct {
+		CreatedAt TimeTime `json:"created_at"`
+		DeletedAt TimeTime `json:"deleted_at"`
+	}
+
+	in := payload{CreatedAt: NewTimeTime(time.Date(2025, 2, 21, 7, 30, 0, 0, time.UTC))}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `{"created_at":"2025-02-21 14:30:00","deleted_at":null}`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+
+	var out payload
+	if err := json.Unmarshal([]byte(`{"created_at":"2025-02-21 14:30:00","deleted_at":"0000-00-00 00:00:00"}`), &out); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !out.CreatedAt.Time().Equal(in.CreatedAt.Time()) {
+		t.Errorf("json.Unmarshal() created_at = %v, want %v", out.CreatedAt, in.CreatedAt)
+	}
+	if !out.DeletedAt.IsZero() {
+		t.Errorf("json.Unmarshal() deleted_at = %v, want zero", out.DeletedAt)
+	}
+	if err := json.Unmarshal([]byte(`{"created_at":"21/02/2025"}`), &out); err == nil {
+		t.Errorf("json.Unmarshal() expected error")
+	}
+}
+
+func TestTimeTimeSQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      interface{}
+		want     string
+		wantZero bool
+		wantErr  bool
+	}{
+		{name: "NULL", src: nil, wantZero: true},
+		{name: "time.Time", src: time.Date(2025, 2, 21, 7, 30, 0, 0, time.UTC), want: "2025-02-21 14:30:00"},
+		{name: "[]byte", src: []byte("2025-02-21 14:30:00"), want: "2025-02-21 14:30:00"},
+		{name: "วันที่ว่างของ MySQL", src: "0000-00-00 00:00:00", wantZero: true},
+		{name: "ชนิดข้อมูลไม่รองรับ", src: 123, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d TimeTime
+			err := d.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if d.IsZero() != tt.wantZero {
+				t.Fatalf("Scan() IsZero = %v, want %v", d.IsZero(), tt.wantZero)
+			}
+			v, _ := d.Value()
+			if tt.wantZero {
+				if v != nil {
+					t.Errorf("Value() = %v, want nil", v)
+				}
+				return
+			}
+			if got := d.DateTime(); got != tt.want {
+				t.Errorf("DateTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateTimeStringHelpers(t *testing.T) {
+	if got, err := ToDatetimeString("2025-02-03T10:15:30.5Z"); err != nil || got != "2025-02-03 17:15:30" {
+		t.Errorf("ToDatetimeString() = %q, %v", got, err)
+	}
+	if got, err := ToDatetimeString("0001-01-01T00:00:00Z"); err != nil || got != "0000-00-00 00:00:00" {
+		t.Errorf("ToDatetimeString() zero = %q, %v", got, err)
+	}
+	if got, err := ToTimeString("2025-02-03T10:15:30+09:00"); err != nil || got != "08:15:30" {
+		t.Errorf("ToTimeString() = %q, %v", got, err)
+	}
+	if got, err := ToDateString("2025-02-03T20:15:30Z"); err != nil || got != "2025-02-04" {
+		t.Errorf("ToDateString() = %q, %v", got, err)
+	}
+	if got, err := ShiftDatetime("2025-02-21T07:30:00Z", 0, 1, 0, 1, 0, 0); err != nil || got != "2025-03-21 15:30:00" {
+		t.Errorf("ShiftDatetime() = %q, %v", got, err)
+	}
+	if got, err := ParseDate("2025-02-21 14:30:00"); err != nil || !got.Equal(time.Date(2025, 2, 21, 0, 0, 0, 0, loadLocation())) {
+		t.Errorf("ParseDate() = %v, %v", got, err)
+	}
+	for _, f := range []func(string) (string, error){ToDateString, ToTimeString, ToDatetimeString} {
+		if _, err := f("2025-13-01"); err == nil {
+			t.Errorf("expected error for invalid month")
+		}
+	}
+	if _, err := ParseDate("not a date"); err == nil {
+		t.Errorf("ParseDate() expected error")
+	}
+}
@@ -0,0 +1,178 @@
+package aider
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DurationDay ระยะเวลา 1 วัน (24 ชั่วโมง) ใช้เป็นหน่วยของ DurationFormatOptions
+const DurationDay = 24 * time.Hour
+
+// DurationFormatOptions กำหนดหน่วยใหญ่สุดและเล็กสุดที่แสดงใน FormatDurationWith
+// หน่วยที่ใช้ได้คือ DurationDay, time.Hour, time.Minute และ time.Second ค่าที่เป็น 0 จะใช้ DurationDay และ time.Second
+type DurationFormatOptions struct {
+	Largest  time.Duration
+	Smallest time.Duration
+}
+
+// หน่วยที่แสดงได้ เรียงจากใหญ่ไปเล็ก
+var durationSteps = []time.Duration{DurationDay, time.Hour, time.Minute, time.Second}
+
+// ชื่อหน่วยของแต่ละภาษา เรียงตาม durationSteps (เอกพจน์, พหูพจน์)
+var durationLabels = map[string][4][2]string{
+	"th": {{"วัน", "วัน"}, {"ชั่วโมง", "ชั่วโมง"}, {"นาที", "นาที"}, {"วินาที", "วินาที"}},
+	"en": {{"day", "days"}, {"hour", "hours"}, {"minute", "minutes"}, {"second", "seconds"}},
+}
+
+// คำเรียกหน่วยที่ ParseDuration รู้จัก
+var durationUnitNames = map[string]time.Duration{
+	"สัปดาห์": 7 * DurationDay, "week": 7 * DurationDay, "weeks": 7 * DurationDay, "w": 7 * DurationDay,
+	"วัน": DurationDay, "day": DurationDay, "days": DurationDay, "d": DurationDay,
+	"ชั่วโมง": time.Hour, "ชม.": time.Hour, "ชม": time.Hour, "hour": time.Hour, "hours": time.Hour, "hr": time.Hour, "hrs": time.Hour, "h": time.Hour,
+	"นาที": time.Minute, "minute": time.Minute, "minutes": time.Minute, "min": time.Minute, "mins": time.Minute, "m": time.Minute,
+	"วินาที": time.Second, "วิ": time.Second, "วิ.": time.Second, "second": time.Second, "seconds": time.Second, "sec": time.Second, "secs": time.Second, "s": time.Second,
+}
+
+// FormatDuration แสดงระยะเวลาเป็นข้อความ เช่น "1 วัน 4 ชั่วโมง" หรือ "2 hours 30 minutes"
+// แสดงเฉพาะหน่วยที่ไม่เป็น 0 ตั้งแต่วันถึงวินาที ส่วนที่เล็กกว่าวินาทีจะถูกตัดทิ้ง
+func FormatDuration(d time.Duration, language string) (string, error) {
+	return FormatDurationWith(d, language, DurationFormatOptions{})
+
+	/*
+		Ex.
+		FormatDuration(150*time.Minute, "th")   // 2 ชั่วโมง 30 นาที
+		FormatDuration(28*time.Hour, "th")      // 1 วัน 4 ชั่วโมง
+		FormatDuration(90*time.Second, "en")    // 1 minute 30 seconds
+		FormatDurationWith(28*time.Hour, "th", DurationFormatOptions{Largest: time.Hour}) // 28 ชั่วโมง
+	*/
+}
+
+// FormatDurationWith เหมือน FormatDuration แต่กำหนดหน่วยใหญ่สุดและเล็กสุดเองได้
+// ส่วนที่เล็กกว่าหน่วยเล็กสุดจะถูกตัดทิ้ง ถ้าไม่มีหน่วยใดเหลือจะแสดง 0 ของหน่วยเล็กสุด
+func FormatDurationWith(d time.Duration, language string, opts DurationFormatOptions) (string, error) {
+	labels, ok := durationLabels[language]
+	if !ok {
+		return "", ErrInvalidLanguage
+	}
+	if opts.Largest == 0 {
+		opts.Largest = DurationDay
+	}
+	if opts.Smallest == 0 {
+		opts.Smallest = time.Second
+	}
+	first, last := durationStepIndex(opts.Largest), durationStepIndex(opts.Smallest)
+	if first < 0 || last < 0 || first > last {
+		return "", errors.New("invalid duration unit")
+	}
+
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+		if d < 0 {
+			// -math.MinInt64 ล้นกลับเป็นค่าลบ ใช้ค่าที่ต่างกัน 1ns แทนเพราะส่วนที่เล็กกว่าวินาทีถูกตัดทิ้งอยู่แล้ว
+			d = math.MaxInt64
+		}
+	}
+
+	var parts []string
+	for i := first; i <= last; i++ {
+		n := d / durationSteps[i]
+		d -= n * durationSteps[i]
+		if n == 0 {
+			continue
+		}
+		label := labels[i][0]
+		if n > 1 {
+			label = labels[i][1]
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", n, label))
+	}
+	if len(parts) == 0 {
+		return "0 " + labels[last][1], nil
+	}
+	return sign + strings.Join(parts, " "), nil
+}
+
+// ParseDuration แปลงข้อความเป็นระยะเวลา รองรับรูปแบบของ Go เช่น "2h30m"
+// และข้อความภาษาไทยหรืออังกฤษ เช่น "2 ชั่วโมง 30 นาที", "1 วัน 4 ชม.", "๒ ชั่วโมง", "1.5 hours", "3d 4h"
+func ParseDuration(s string) (time.Duration, error) {
+	input := s
+	s = strings.ToLower(strings.TrimSpace(fromThaiDigits(s)))
+	if s == "" {
+		return 0, fmt.Errorf("invalid duration %q: empty string", input)
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+
+	negative := false
+	if rest, ok := strings.CutPrefix(s, "-"); ok {
+		negative, s = true, rest
+	}
+
+	var total time.Duration
+	found := false
+	for s = skipDurationSeparators(s); s != ""; s = skipDurationSeparators(s) {
+		i := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsDigit(r) && r != '.' })
+		if i == 0 {
+			return 0, fmt.Errorf("invalid duration %q: expected number at %q", input, s)
+		}
+		if i < 0 {
+			return 0, fmt.Errorf("invalid duration %q: missing unit after %q", input, s)
+		}
+		n, err := strconv.ParseFloat(s[:i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: invalid number %q", input, s[:i])
+		}
+
+		s = strings.TrimLeft(s[i:], " ")
+		j := strings.IndexFunc(s, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsDigit(r) || r == ',' })
+		if j < 0 {
+			j = len(s)
+		}
+		unit, ok := durationUnitNames[s[:j]]
+		if !ok {
+			return 0, fmt.Errorf("invalid duration %q: unknown unit %q", input, s[:j])
+		}
+		total += time.Duration(n * float64(unit))
+		found = true
+		s = s[j:]
+	}
+	if !found {
+		return 0, fmt.Errorf("invalid duration %q", input)
+	}
+	if negative {
+		total = -total
+	}
+	return total, nil
+}
+
+// ตำแหน่งของหน่วยใน durationSteps หรือ -1 ถ้าไม่ใช่หน่วยที่รองรับ
+func durationStepIndex(unit time.Duration) int {
+	for i, step := range durationSteps {
+		if step == unit {
+			return i
+		}
+	}
+	return -1
+}
+
+// ตัดช่องว่าง จุลภาค และคำเชื่อม "และ" / "and" ที่อยู่ข้างหน้าออก
+func skipDurationSeparators(s string) string {
+	for {
+		trimmed := strings.TrimLeft(s, " \t,")
+		trimmed = strings.TrimPrefix(trimmed, "และ")
+		if rest, ok := strings.CutPrefix(trimmed, "and"); ok && (rest == "" || rest[0] == ' ') {
+			trimmed = rest
+		}
+		if trimmed == s {
+			return s
+		}
+		s = trimmed
+	}
+}
@@ -0,0 +1,75 @@
+package aider
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		d        time.Duration
+		language string
+		opts     DurationFormatOptions
+		want     string
+	}{
+		{name: "ชั่วโมงและนาที", d: 150 * time.Minute, language: "th", want: "2 ชั่วโมง 30 นาที"},
+		{name: "วันและชั่วโมง", d: 28 * time.Hour, language: "th", want: "1 วัน 4 ชั่วโมง"},
+		{name: "ภาษาอังกฤษ", d: 90 * time.Second, language: "en", want: "1 minute 30 seconds"},
+		{name: "จำกัดหน่วยใหญ่สุด", d: 28 * time.Hour, language: "th", opts: DurationFormatOptions{Largest: time.Hour}, want: "28 ชั่วโมง"},
+		{name: "จำกัดหน่วยเล็กสุด", d: 2*time.Hour + 59*time.Second, language: "en", opts: DurationFormatOptions{Smallest: time.Minute}, want: "2 hours"},
+		{name: "ค่าลบ", d: -45 * time.Minute, language: "en", want: "-45 minutes"},
+		{name: "ศูนย์", d: 500 * time.Millisecond, language: "th", want: "0 วินาที"},
+		{name: "ค่าลบต่ำสุด", d: math.MinInt64, language: "en", want: "-106751 days 23 hours 47 minutes 16 seconds"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FormatDurationWith(tt.d, tt.language, tt.opts)
+			if err != nil {
+				t.Fatalf("FormatDurationWith() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatDurationWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := FormatDurationWith(time.Hour, "th", DurationFormatOptions{Largest: time.Second, Smallest: time.Hour}); err == nil {
+		t.Errorf("FormatDurationWith() expected error for invalid units")
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "รูปแบบ Go", input: "2h30m", want: 150 * time.Minute},
+		{name: "ภาษาไทย", input: "2 ชั่วโมง 30 นาที", want: 150 * time.Minute},
+		{name: "ภาษาไทยแบบย่อ", input: "1 วัน 4 ชม.", want: 28 * time.Hour},
+		{name: "ไม่มีช่องว่าง", input: "2ชม.30นาที", want: 150 * time.Minute},
+		{name: "เลขไทยและคำเชื่อม", input: "๑ ชั่วโมง และ ๑๕ นาที", want: 75 * time.Minute},
+		{name: "ภาษาอังกฤษ", input: "1 day, 2 hours and 5 minutes", want: 26*time.Hour + 5*time.Minute},
+		{name: "ทศนิยม", input: "1.5 hours", want: 90 * time.Minute},
+		{name: "หน่วยวัน", input: "3d 4h", want: 76 * time.Hour},
+		{name: "ค่าลบ", input: "-2 นาที", want: -2 * time.Minute},
+		{name: "หน่วยไม่รู้จัก", input: "3 ปี", wantErr: true},
+		{name: "น. หมายถึงนาฬิกาไม่ใช่นาที", input: "14 น.", wantErr: true},
+		{name: "ไม่มีหน่วย", input: "15", wantErr: true},
+		{name: "ว่าง", input: " ", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDuration(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDuration() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,13 @@
 package aider
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"runtime"
+)
 
 // Define constants for error codes
+// These are HTTP status numbers kept for compatibility; see ErrorCode for the canonical codes.
 const (
 	ErrNotFound     = 404
 	ErrUnauthorized = 401
@@ -10,15 +15,81 @@ const (
 	ErrBadRequest   = 400
 )
 
+// maximum number of stack frames captured by NewError and Wrap
+const maxStackDepth = 32
+
 // CustomError defines a struct for handling error code and message.
+// Err holds the underlying cause (if any) and Details holds optional fields
+// such as the offending parameter or resource id.
+// MessageID selects the message catalog entry used by Localize (see MessageCatalog).
+// Code is a canonical code (CodeNotFound, ...) or a legacy HTTP status (ErrNotFound, ...);
+// use ErrorCode for its typed view.
 type CustomError struct {
-	Code    int
-	Message string
+	Code      int
+	Message   string
+	MessageID string
+	Details   map[string]any
+	Err       error
+
+	stack []uintptr
 }
 
 // Implement the Error method to satisfy the error interface.
 func (e *CustomError) Error() string {
-	return fmt.Sprintf("Error %d: %s", e.Code, e.Message)
+	switch {
+	case e.Err == nil:
+		return fmt.Sprintf("Error %d: %s", e.Code, e.Message)
+	case e.Message == "":
+		return fmt.Sprintf("Error %d: %v", e.Code, e.Err)
+	}
+	return fmt.Sprintf("Error %d: %s: %v", e.Code, e.Message, e.Err)
+}
+
+// ErrorCode returns Code as an ErrorCode, e.g. e.ErrorCode().HTTPStatus().
+func (e *CustomError) ErrorCode() ErrorCode {
+	return ErrorCode(e.Code)
+}
+
+// Unwrap returns the underlying cause so errors.Is and errors.As can walk the chain.
+func (e *CustomError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is a *CustomError with the same code.
+// Two legacy HTTP status codes must be equal; a legacy code and a canonical code
+// match when the legacy code resolves to it, so ErrNotFound matches CodeNotFound.
+// A target with an empty Message matches by code only, so
+// errors.Is(err, &CustomError{Code: ErrNotFound}) matches any not-found error.
+func (e *CustomError) Is(target error) bool {
+	t, ok := target.(*CustomError)
+	if !ok {
+		return false
+	}
+	return sameErrorCode(e.ErrorCode(), t.ErrorCode()) && (t.Message == "" || t.Message == e.Message)
+}
+
+func sameErrorCode(a, b ErrorCode) bool {
+	if a.IsHTTPStatus() && b.IsHTTPStatus() {
+		return a == b
+	}
+	return a.Canonical() == b.Canonical()
+}
+
+// StackTrace returns the call stack captured when the error was created.
+// Errors built with a struct literal have no stack.
+func (e *CustomError) StackTrace() []runtime.Frame {
+	if len(e.stack) == 0 {
+		return nil
+	}
+	var result []runtime.Frame
+	frames := runtime.CallersFrames(e.stack)
+	for {
+		frame, more := frames.Next()
+		result = append(result, frame)
+		if !more {
+			return result
+		}
+	}
 }
 
 // NewError is a constructor function to create a new CustomError.
@@ -26,5 +97,78 @@ func NewError(code int, message string) error {
 	return &CustomError{
 		Code:    code,
 		Message: message,
+		stack:   callers(),
 	}
 }
+
+// Wrap annotates err with a code and message while keeping err as the cause.
+// It returns nil when err is nil.
+func Wrap(err error, code int, message string) error {
+	if err == nil {
+		return nil
+	}
+	return &CustomError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+		stack:   callers(),
+	}
+
+	/*
+		Ex.
+		if err := db.First(&user, id).Error; err != nil {
+			return Wrap(err, ErrNotFound, "user not found")
+		}
+		...
+		errors.Is(err, gorm.ErrRecordNotFound)              // true
+		errors.Is(err, &CustomError{Code: ErrNotFound})     // true
+		CodeOf(err)                                         // 404 (NOT_FOUND)
+	*/
+}
+
+// WithDetails returns a copy of err with details merged into its Details map.
+// If err is not a *CustomError it is wrapped using the code from CodeOf.
+func WithDetails(err error, details map[string]any) error {
+	if err == nil {
+		return nil
+	}
+	e, ok := err.(*CustomError)
+	if !ok {
+		e = &CustomError{Code: int(CodeOf(err)), Err: err, stack: callers()}
+	}
+	merged := make(map[string]any, len(e.Details)+len(details))
+	for k, v := range e.Details {
+		merged[k] = v
+	}
+	for k, v := range details {
+		merged[k] = v
+	}
+	c := *e
+	c.Details = merged
+	return &c
+
+	/*
+		Ex.
+		err := WithDetails(NewError(ErrBadRequest, "invalid amount"), map[string]any{"field": "amount", "max": 1000})
+	*/
+}
+
+// CodeOf returns the code of the first *CustomError in err's chain,
+// ErrInternal for other errors, and 0 when err is nil.
+func CodeOf(err error) ErrorCode {
+	if err == nil {
+		return 0
+	}
+	var e *CustomError
+	if errors.As(err, &e) {
+		return e.ErrorCode()
+	}
+	return ErrInternal
+}
+
+func callers() []uintptr {
+	pcs := make([]uintptr, maxStackDepth)
+	// skip runtime.Callers, callers and the constructor itself
+	n := runtime.Callers(3, pcs)
+	return pcs[:n]
+}
@@ -0,0 +1,122 @@
+package aider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCustomErrorError(t *testing.T) {
+	cause := errors.New("connection refused")
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "ไม่มี cause", err: NewError(ErrNotFound, "user not found"), want: "Error 404: user not found"},
+		{name: "มี cause", err: Wrap(cause, ErrInternal, "load user"), want: "Error 500: load user: connection refused"},
+		{name: "ไม่มีข้อความ", err: &CustomError{Code: ErrInternal, Err: cause}, want: "Error 500: connection refused"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCustomErrorMatching(t *testing.T) {
+	cause := errors.New("record not found")
+	err := fmt.Errorf("handler: %w", Wrap(cause, ErrNotFound, "user not found"))
+
+	tests := []struct {
+		name   string
+		target error
+		want   bool
+	}{
+		{name: "cause เดิม", target: cause, want: true},
+		{name: "ตรงรหัส", target: &CustomError{Code: ErrNotFound}, want: true},
+		{name: "ตรงรหัสและข้อความ", target: &CustomError{Code: ErrNotFound, Message: "user not found"}, want: true},
+		{name: "ข้อความต่างกัน", target: &CustomError{Code: ErrNotFound, Message: "order not found"}, want: false},
+		{name: "รหัสต่างกัน", target: &CustomError{Code: ErrBadRequest}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(err, tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var ce *CustomError
+	if !errors.As(err, &ce) || ce.Code != ErrNotFound {
+		t.Errorf("errors.As() = %v, want CustomError with code %d", ce, ErrNotFound)
+	}
+}
+
+func TestCodeOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want ErrorCode
+	}{
+		{name: "nil", err: nil, want: 0},
+		{name: "CustomError", err: NewError(ErrUnauthorized, "token expired"), want: ErrUnauthorized},
+		{name: "ถูกห่อด้วย fmt.Errorf", err: fmt.Errorf("middleware: %w", NewError(ErrBadRequest, "bad input")), want: ErrBadRequest},
+		{name: "error ทั่วไป", err: errors.New("boom"), want: ErrInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CodeOf(tt.err); got != tt.want {
+				t.Errorf("CodeOf() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrapNil(t *testing.T) {
+	if err := Wrap(nil, ErrInternal, "nothing"); err != nil {
+		t.Errorf("Wrap(nil) = %v, want nil", err)
+	}
+	if err := WithDetails(nil, map[string]any{"a": 1}); err != nil {
+		t.Errorf("WithDetails(nil) = %v, want nil", err)
+	}
+}
+
+func TestWithDetails(t *testing.T) {
+	base := WithDetails(NewError(ErrBadRequest, "invalid amount"), map[string]any{"field": "amount"})
+	err := WithDetails(base, map[string]any{"max": 1000})
+
+	var ce *CustomError
+	if !errors.As(err, &ce) {
+		t.Fatalf("WithDetails() = %T, want *CustomError", err)
+	}
+	if ce.Details["field"] != "amount" || ce.Details["max"] != 1000 {
+		t.Errorf("Details = %v, want field and max", ce.Details)
+	}
+	if _, ok := base.(*CustomError).Details["max"]; ok {
+		t.Error("WithDetails() should not modify the original error")
+	}
+
+	plain := errors.New("timeout")
+	err = WithDetails(plain, map[string]any{"retry": true})
+	if CodeOf(err) != ErrInternal || !errors.Is(err, plain) {
+		t.Errorf("WithDetails(plain) = %v, want internal error wrapping the cause", err)
+	}
+}
+
+func TestStackTrace(t *testing.T) {
+	err := NewError(ErrInternal, "boom").(*CustomError)
+	frames := err.StackTrace()
+	if len(frames) == 0 {
+		t.Fatal("StackTrace() is empty")
+	}
+	if !strings.HasSuffix(frames[0].Function, "TestStackTrace") {
+		t.Errorf("first frame = %s, want TestStackTrace", frames[0].Function)
+	}
+	if (&CustomError{Code: ErrInternal}).StackTrace() != nil {
+		t.Error("StackTrace() of a struct literal should be nil")
+	}
+}
@@ -0,0 +1,175 @@
+package aider
+
+import "strconv"
+
+// ErrorCode is the typed view of CustomError.Code (see CustomError.ErrorCode and CodeOf).
+//
+// The canonical codes below use the numeric values of the gRPC status codes (0-16),
+// plus CodeValidation and CodeBusinessRule for application level errors.
+// The legacy HTTP status constants (ErrNotFound, ErrBadRequest, ...) are still valid codes:
+// any value in the 100-599 range is treated as an HTTP status and resolved with Canonical.
+type ErrorCode int
+
+// Canonical error codes.
+// They are untyped so they can be used wherever a CustomError code (an int) or an ErrorCode is expected,
+// e.g. NewError(CodeNotFound, "user not found") or ErrorCode(CodeNotFound).HTTPStatus().
+const (
+	CodeOK                 = 0
+	CodeCanceled           = 1
+	CodeUnknown            = 2
+	CodeInvalidArgument    = 3
+	CodeDeadlineExceeded   = 4
+	CodeNotFound           = 5
+	CodeAlreadyExists      = 6
+	CodePermissionDenied   = 7
+	CodeResourceExhausted  = 8
+	CodeFailedPrecondition = 9
+	CodeAborted            = 10
+	CodeOutOfRange         = 11
+	CodeUnimplemented      = 12
+	CodeInternal           = 13
+	CodeUnavailable        = 14
+	CodeDataLoss           = 15
+	CodeUnauthenticated    = 16
+
+	CodeValidation   = 1000 // request failed field validation (gRPC InvalidArgument, HTTP 422)
+	CodeBusinessRule = 1001 // request violates a business rule (gRPC FailedPrecondition, HTTP 422)
+)
+
+type errorCodeInfo struct {
+	name       string
+	httpStatus int
+	grpcCode   ErrorCode
+}
+
+var errorCodes = map[ErrorCode]errorCodeInfo{
+	CodeOK:                 {"OK", 200, CodeOK},
+	CodeCanceled:           {"CANCELED", 499, CodeCanceled},
+	CodeUnknown:            {"UNKNOWN", 500, CodeUnknown},
+	CodeInvalidArgument:    {"INVALID_ARGUMENT", 400, CodeInvalidArgument},
+	CodeDeadlineExceeded:   {"DEADLINE_EXCEEDED", 504, CodeDeadlineExceeded},
+	CodeNotFound:           {"NOT_FOUND", 404, CodeNotFound},
+	CodeAlreadyExists:      {"ALREADY_EXISTS", 409, CodeAlreadyExists},
+	CodePermissionDenied:   {"PERMISSION_DENIED", 403, CodePermissionDenied},
+	CodeResourceExhausted:  {"RESOURCE_EXHAUSTED", 429, CodeResourceExhausted},
+	CodeFailedPrecondition: {"FAILED_PRECONDITION", 400, CodeFailedPrecondition},
+	CodeAborted:            {"ABORTED", 409, CodeAborted},
+	CodeOutOfRange:         {"OUT_OF_RANGE", 400, CodeOutOfRange},
+	CodeUnimplemented:      {"UNIMPLEMENTED", 501, CodeUnimplemented},
+	CodeInternal:           {"INTERNAL", 500, CodeInternal},
+	CodeUnavailable:        {"UNAVAILABLE", 503, CodeUnavailable},
+	CodeDataLoss:           {"DATA_LOSS", 500, CodeDataLoss},
+	CodeUnauthenticated:    {"UNAUTHENTICATED", 401, CodeUnauthenticated},
+	CodeValidation:         {"VALIDATION", 422, CodeInvalidArgument},
+	CodeBusinessRule:       {"BUSINESS_RULE", 422, CodeFailedPrecondition},
+}
+
+// HTTP statuses that map to a specific code, other statuses fall back by class in ErrorCodeFromHTTP
+var httpStatusCodes = map[int]ErrorCode{
+	400: CodeInvalidArgument,
+	401: CodeUnauthenticated,
+	403: CodePermissionDenied,
+	404: CodeNotFound,
+	408: CodeDeadlineExceeded,
+	409: CodeAlreadyExists,
+	410: CodeNotFound,
+	412: CodeFailedPrecondition,
+	416: CodeOutOfRange,
+	422: CodeValidation,
+	429: CodeResourceExhausted,
+	499: CodeCanceled,
+	500: CodeInternal,
+	501: CodeUnimplemented,
+	503: CodeUnavailable,
+	504: CodeDeadlineExceeded,
+}
+
+// ErrorCodeFromHTTP returns the canonical code for an HTTP status.
+func ErrorCodeFromHTTP(status int) ErrorCode {
+	if c, ok := httpStatusCodes[status]; ok {
+		return c
+	}
+	switch {
+	case status >= 200 && status < 400:
+		return CodeOK
+	case status >= 400 && status < 500:
+		return CodeFailedPrecondition
+	case status >= 500 && status < 600:
+		return CodeInternal
+	}
+	return CodeUnknown
+}
+
+// ErrorCodeFromGRPC returns the canonical code for a numeric gRPC status code.
+func ErrorCodeFromGRPC(code int) ErrorCode {
+	if code >= int(CodeOK) && code <= int(CodeUnauthenticated) {
+		return ErrorCode(code)
+	}
+	return CodeUnknown
+}
+
+// IsHTTPStatus reports whether c is a legacy HTTP status code such as ErrNotFound.
+func (c ErrorCode) IsHTTPStatus() bool {
+	return c >= 100 && c <= 599
+}
+
+// Canonical resolves a legacy HTTP status code to its canonical code.
+// Canonical codes are returned unchanged.
+func (c ErrorCode) Canonical() ErrorCode {
+	if c.IsHTTPStatus() {
+		return ErrorCodeFromHTTP(int(c))
+	}
+	return c
+}
+
+// String returns the name of the code, e.g. "NOT_FOUND".
+func (c ErrorCode) String() string {
+	if info, ok := errorCodes[c.Canonical()]; ok {
+		return info.name
+	}
+	return "CODE(" + strconv.Itoa(int(c)) + ")"
+}
+
+// HTTPStatus returns the HTTP status for c.
+// Legacy HTTP status codes are returned as is and unknown codes map to 500.
+func (c ErrorCode) HTTPStatus() int {
+	if c.IsHTTPStatus() {
+		return int(c)
+	}
+	if info, ok := errorCodes[c]; ok {
+		return info.httpStatus
+	}
+	return 500
+}
+
+// GRPCCode returns the numeric gRPC status code for c (unknown codes map to 2 Unknown).
+func (c ErrorCode) GRPCCode() int {
+	if info, ok := errorCodes[c.Canonical()]; ok {
+		return int(info.grpcCode)
+	}
+	return int(CodeUnknown)
+}
+
+// Retryable reports whether a request that failed with c may succeed if retried
+// (Unavailable, DeadlineExceeded, ResourceExhausted and Aborted).
+func (c ErrorCode) Retryable() bool {
+	switch c.Canonical() {
+	case CodeUnavailable, CodeDeadlineExceeded, CodeResourceExhausted, CodeAborted:
+		return true
+	}
+	return false
+
+	/*
+		Ex.
+		err := NewError(CodeUnavailable, "payment gateway is down")
+		CodeOf(err).Retryable()   // true
+		CodeOf(err).HTTPStatus()  // 503
+		CodeOf(err).GRPCCode()    // 14
+		ErrorCode(ErrNotFound).Canonical() == CodeNotFound // true
+	*/
+}
+
+// IsRetryable reports whether err carries a retryable code (see ErrorCode.Retryable).
+func IsRetryable(err error) bool {
+	return err != nil && CodeOf(err).Retryable()
+}
@@ -0,0 +1,120 @@
+package aider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrorCodeMappings(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      ErrorCode
+		wantName  string
+		wantHTTP  int
+		wantGRPC  int
+		wantRetry bool
+		wantCanon ErrorCode
+	}{
+		{name: "NotFound", code: CodeNotFound, wantName: "NOT_FOUND", wantHTTP: 404, wantGRPC: 5, wantCanon: CodeNotFound},
+		{name: "AlreadyExists", code: CodeAlreadyExists, wantName: "ALREADY_EXISTS", wantHTTP: 409, wantGRPC: 6, wantCanon: CodeAlreadyExists},
+		{name: "ResourceExhausted", code: CodeResourceExhausted, wantName: "RESOURCE_EXHAUSTED", wantHTTP: 429, wantGRPC: 8, wantRetry: true, wantCanon: CodeResourceExhausted},
+		{name: "Unavailable", code: CodeUnavailable, wantName: "UNAVAILABLE", wantHTTP: 503, wantGRPC: 14, wantRetry: true, wantCanon: CodeUnavailable},
+		{name: "DeadlineExceeded", code: CodeDeadlineExceeded, wantName: "DEADLINE_EXCEEDED", wantHTTP: 504, wantGRPC: 4, wantRetry: true, wantCanon: CodeDeadlineExceeded},
+		{name: "Validation", code: CodeValidation, wantName: "VALIDATION", wantHTTP: 422, wantGRPC: 3, wantCanon: CodeValidation},
+		{name: "BusinessRule", code: CodeBusinessRule, wantName: "BUSINESS_RULE", wantHTTP: 422, wantGRPC: 9, wantCanon: CodeBusinessRule},
+		{name: "ค่าเดิม ErrNotFound", code: ErrNotFound, wantName: "NOT_FOUND", wantHTTP: 404, wantGRPC: 5, wantCanon: CodeNotFound},
+		{name: "ค่าเดิม ErrUnauthorized", code: ErrUnauthorized, wantName: "UNAUTHENTICATED", wantHTTP: 401, wantGRPC: 16, wantCanon: CodeUnauthenticated},
+		{name: "ค่าเดิม ErrInternal", code: ErrInternal, wantName: "INTERNAL", wantHTTP: 500, wantGRPC: 13, wantCanon: CodeInternal},
+		{name: "HTTP 418 ใช้สถานะเดิม", code: 418, wantName: "FAILED_PRECONDITION", wantHTTP: 418, wantGRPC: 9, wantCanon: CodeFailedPrecondition},
+		{name: "รหัสที่ไม่รู้จัก", code: 9999, wantName: "CODE(9999)", wantHTTP: 500, wantGRPC: 2, wantCanon: 9999},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.code.String(); got != tt.wantName {
+				t.Errorf("String() = %q, want %q", got, tt.wantName)
+			}
+			if got := tt.code.HTTPStatus(); got != tt.wantHTTP {
+				t.Errorf("HTTPStatus() = %d, want %d", got, tt.wantHTTP)
+			}
+			if got := tt.code.GRPCCode(); got != tt.wantGRPC {
+				t.Errorf("GRPCCode() = %d, want %d", got, tt.wantGRPC)
+			}
+			if got := tt.code.Retryable(); got != tt.wantRetry {
+				t.Errorf("Retryable() = %v, want %v", got, tt.wantRetry)
+			}
+			if got := tt.code.Canonical(); got != tt.wantCanon {
+				t.Errorf("Canonical() = %v, want %v", got, tt.wantCanon)
+			}
+		})
+	}
+}
+
+func TestErrorCodeFrom(t *testing.T) {
+	httpTests := []struct {
+		status int
+		want   ErrorCode
+	}{
+		{200, CodeOK}, {400, CodeInvalidArgument}, {401, CodeUnauthenticated}, {403, CodePermissionDenied},
+		{404, CodeNotFound}, {409, CodeAlreadyExists}, {422, CodeValidation}, {429, CodeResourceExhausted},
+		{503, CodeUnavailable}, {504, CodeDeadlineExceeded}, {502, CodeInternal}, {999, CodeUnknown},
+	}
+	for _, tt := range httpTests {
+		if got := ErrorCodeFromHTTP(tt.status); got != tt.want {
+			t.Errorf("ErrorCodeFromHTTP(%d) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+
+	for c := ErrorCode(CodeOK); c <= CodeUnauthenticated; c++ {
+		if got := ErrorCodeFromGRPC(c.GRPCCode()); got != c {
+			t.Errorf("ErrorCodeFromGRPC(%d) = %v, want %v", c.GRPCCode(), got, c)
+		}
+	}
+	if got := ErrorCodeFromGRPC(42); got != CodeUnknown {
+		t.Errorf("ErrorCodeFromGRPC(42) = %v, want UNKNOWN", got)
+	}
+}
+
+func TestErrorCodeMatching(t *testing.T) {
+	err := fmt.Errorf("repo: %w", NewError(ErrNotFound, "user not found"))
+	if !errors.Is(err, &CustomError{Code: CodeNotFound}) {
+		t.Error("legacy ErrNotFound should match CodeNotFound")
+	}
+	if !errors.Is(NewError(CodeNotFound, "x"), &CustomError{Code: ErrNotFound}) {
+		t.Error("CodeNotFound should match legacy ErrNotFound")
+	}
+	if errors.Is(NewError(410, "gone"), &CustomError{Code: ErrNotFound}) {
+		t.Error("410 should not match legacy 404")
+	}
+	if errors.Is(NewError(418, "teapot"), &CustomError{Code: 405}) {
+		t.Error("418 should not match legacy 405")
+	}
+	if errors.Is(NewError(502, "bad gateway"), &CustomError{Code: ErrInternal}) {
+		t.Error("502 should not match legacy 500")
+	}
+	if !errors.Is(NewError(410, "gone"), &CustomError{Code: CodeNotFound}) {
+		t.Error("legacy 410 should match CodeNotFound")
+	}
+	if !IsRetryable(Wrap(errors.New("i/o timeout"), CodeDeadlineExceeded, "call inventory")) {
+		t.Error("IsRetryable() = false, want true for DeadlineExceeded")
+	}
+	if IsRetryable(errors.New("boom")) || IsRetryable(nil) {
+		t.Error("IsRetryable() = true, want false for plain and nil errors")
+	}
+}
+
+func TestCustomErrorIntCode(t *testing.T) {
+	// Code ยังเป็น int เหมือนเดิม ใช้กับโค้ดที่ส่งรหัสเป็น int หรือใช้เป็นสถานะ HTTP ได้โดยตรง
+	code := 404
+	var e *CustomError
+	if !errors.As(NewError(code, "user not found"), &e) {
+		t.Fatal("NewError() did not return a *CustomError")
+	}
+	var status int = e.Code
+	if status != 404 || e.ErrorCode().Canonical() != CodeNotFound {
+		t.Errorf("Code = %d, ErrorCode().Canonical() = %v, want 404 and NOT_FOUND", status, e.ErrorCode().Canonical())
+	}
+	if got := (&CustomError{Code: CodeUnavailable}).ErrorCode().HTTPStatus(); got != 503 {
+		t.Errorf("ErrorCode().HTTPStatus() = %d, want 503", got)
+	}
+}
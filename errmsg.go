@@ -0,0 +1,259 @@
+package aider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MessageCatalog holds message templates keyed by message ID and language.
+// Templates use {name} placeholders that are filled from the error Details, e.g.
+// "ไม่พบผู้ใช้ {id}" / "user {id} not found".
+// A CustomError without MessageID or Message is localized by its ErrorCode name (e.g. "NOT_FOUND").
+type MessageCatalog struct {
+	mu        sync.RWMutex
+	fallback  string
+	messages  map[string]map[string]string
+	languages map[string]bool
+}
+
+// DefaultMessageCatalog is the catalog used by NewMessageError and LocalizeError.
+// It contains Thai and English messages for every canonical ErrorCode and falls back to Thai.
+var DefaultMessageCatalog = NewMessageCatalog(languageTh)
+
+var messagePlaceholder = regexp.MustCompile(`\{(\w+)\}`)
+
+func init() {
+	for id, m := range map[string][2]string{
+		"OK":                  {"สำเร็จ", "success"},
+		"CANCELED":            {"คำขอถูกยกเลิก", "request canceled"},
+		"UNKNOWN":             {"เกิดข้อผิดพลาดที่ไม่ทราบสาเหตุ", "unknown error"},
+		"INVALID_ARGUMENT":    {"ข้อมูลไม่ถูกต้อง", "invalid argument"},
+		"DEADLINE_EXCEEDED":   {"หมดเวลาดำเนินการ", "deadline exceeded"},
+		"NOT_FOUND":           {"ไม่พบข้อมูล", "not found"},
+		"ALREADY_EXISTS":      {"มีข้อมูลนี้อยู่แล้ว", "already exists"},
+		"PERMISSION_DENIED":   {"ไม่มีสิทธิ์เข้าถึง", "permission denied"},
+		"RESOURCE_EXHAUSTED":  {"มีการเรียกใช้งานมากเกินไป กรุณาลองใหม่ภายหลัง", "too many requests, please try again later"},
+		"FAILED_PRECONDITION": {"ไม่สามารถดำเนินการได้ในสถานะปัจจุบัน", "operation not allowed in the current state"},
+		"ABORTED":             {"การดำเนินการถูกยกเลิกเนื่องจากข้อมูลขัดแย้งกัน", "operation aborted due to a conflict"},
+		"OUT_OF_RANGE":        {"ค่าอยู่นอกช่วงที่กำหนด", "value out of range"},
+		"UNIMPLEMENTED":       {"ยังไม่รองรับการทำงานนี้", "not implemented"},
+		"INTERNAL":            {"เกิดข้อผิดพลาดภายในระบบ", "internal server error"},
+		"UNAVAILABLE":         {"ระบบไม่พร้อมให้บริการชั่วคราว", "service unavailable"},
+		"DATA_LOSS":           {"ข้อมูลสูญหายหรือเสียหาย", "data loss"},
+		"UNAUTHENTICATED":     {"กรุณาเข้าสู่ระบบ", "authentication required"},
+		"VALIDATION":          {"ข้อมูลไม่ผ่านการตรวจสอบ", "validation failed"},
+		"BUSINESS_RULE":       {"ไม่เป็นไปตามเงื่อนไขที่กำหนด", "business rule violated"},
+	} {
+		DefaultMessageCatalog.Set(id, map[string]string{languageTh: m[0], "en": m[1]})
+	}
+}
+
+// NewMessageCatalog creates an empty catalog.
+// fallback is the language used when none of the requested languages has a message.
+func NewMessageCatalog(fallback string) *MessageCatalog {
+	return &MessageCatalog{
+		fallback:  strings.ToLower(fallback),
+		messages:  map[string]map[string]string{},
+		languages: map[string]bool{},
+	}
+}
+
+// Set adds or replaces the templates of id, keyed by language.
+func (c *MessageCatalog) Set(id string, templates map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	m, ok := c.messages[id]
+	if !ok {
+		m = map[string]string{}
+		c.messages[id] = m
+	}
+	for lang, tmpl := range templates {
+		lang = strings.ToLower(lang)
+		m[lang] = tmpl
+		c.languages[lang] = true
+	}
+}
+
+// Message renders the template of id in language, filling {name} placeholders from params.
+// language may be a language code ("th", "en-US") or an Accept-Language header value.
+// If the message has no template in that language the fallback language is used;
+// it returns false when id is not in the catalog.
+func (c *MessageCatalog) Message(id, language string, params map[string]any) (string, bool) {
+	lang := c.MatchLanguage(language)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	m, ok := c.messages[id]
+	if !ok {
+		return "", false
+	}
+	tmpl, ok := m[lang]
+	if !ok {
+		if tmpl, ok = m[c.fallback]; !ok {
+			return "", false
+		}
+	}
+	return renderMessage(tmpl, params), true
+
+	/*
+		Ex.
+		catalog.Set("USER_NOT_FOUND", map[string]string{"th": "ไม่พบผู้ใช้ {id}", "en": "user {id} not found"})
+		catalog.Message("USER_NOT_FOUND", "en", map[string]any{"id": 42})              // user 42 not found
+		catalog.Message("USER_NOT_FOUND", "th-TH,th;q=0.9,en;q=0.8", map[string]any{"id": 42}) // ไม่พบผู้ใช้ 42
+	*/
+}
+
+// MatchLanguage picks the best language in the catalog for a language code or an
+// Accept-Language header (honouring q values), or the fallback language if none match.
+func (c *MessageCatalog) MatchLanguage(acceptLanguage string) string {
+	type candidate struct {
+		tag string
+		q   float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		if q > 0 {
+			candidates = append(candidates, candidate{strings.ToLower(tag), q})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, cand := range candidates {
+		if c.languages[cand.tag] {
+			return cand.tag
+		}
+		if primary, _, ok := strings.Cut(cand.tag, "-"); ok && c.languages[primary] {
+			return primary
+		}
+	}
+	return c.fallback
+}
+
+// Localize returns a copy of the first CustomError in err's chain with Message rendered in language
+// (a language code or an Accept-Language header value), using Details as template parameters.
+// The message is looked up by MessageID; only when both MessageID and Message are empty
+// is the code name used, so a specific caller message is never replaced by a generic one.
+// Errors without a CustomError, or without a matching catalog entry, are returned unchanged.
+func (c *MessageCatalog) Localize(err error, language string) error {
+	var e *CustomError
+	if !errors.As(err, &e) {
+		return err
+	}
+	id := e.MessageID
+	if id == "" {
+		if e.Message != "" {
+			return err
+		}
+		id = e.ErrorCode().String()
+	}
+	msg, ok := c.Message(id, language, e.Details)
+	if !ok {
+		return err
+	}
+	localized := *e
+	localized.Message = msg
+	return &localized
+
+	/*
+		Ex.
+		err := NewMessageError(CodeNotFound, "USER_NOT_FOUND", map[string]any{"id": 42})
+		LocalizeError(err, r.Header.Get("Accept-Language")) // Error 5: ไม่พบผู้ใช้ 42
+		LocalizeError(NewError(ErrNotFound, ""), "en")      // Error 404: not found
+		LocalizeError(NewError(ErrNotFound, "user 42 not found"), "th") // ข้อความเดิม ไม่มี MessageID
+	*/
+}
+
+// LoadJSON loads templates from JSON of the form
+// {"USER_NOT_FOUND": {"th": "ไม่พบผู้ใช้ {id}", "en": "user {id} not found"}}.
+func (c *MessageCatalog) LoadJSON(r io.Reader) error {
+	if err := c.loadJSON(r); err != nil {
+		return fmt.Errorf("load message catalog: %w", err)
+	}
+	return nil
+}
+
+// LoadFile loads templates from a JSON file (see LoadJSON).
+func (c *MessageCatalog) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("load message catalog: %w", err)
+	}
+	defer f.Close()
+	if err := c.loadJSON(f); err != nil {
+		return fmt.Errorf("load message catalog %s: %w", path, err)
+	}
+	return nil
+}
+
+func (c *MessageCatalog) loadJSON(r io.Reader) error {
+	var data map[string]map[string]string
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return err
+	}
+	for id, templates := range data {
+		c.Set(id, templates)
+	}
+	return nil
+}
+
+// NewMessageError creates a CustomError whose message comes from DefaultMessageCatalog.
+// Message is rendered in the catalog fallback language (messageID itself if it is not in the catalog)
+// and params are kept in Details so the error can be localized again with LocalizeError.
+func NewMessageError(code int, messageID string, params map[string]any) error {
+	msg, ok := DefaultMessageCatalog.Message(messageID, DefaultMessageCatalog.fallback, params)
+	if !ok {
+		msg = messageID
+	}
+	var details map[string]any
+	if len(params) > 0 {
+		details = make(map[string]any, len(params))
+		for k, v := range params {
+			details[k] = v
+		}
+	}
+	return &CustomError{
+		Code:      code,
+		Message:   msg,
+		MessageID: messageID,
+		Details:   details,
+		stack:     callers(),
+	}
+}
+
+// LocalizeError localizes err with DefaultMessageCatalog (see MessageCatalog.Localize).
+func LocalizeError(err error, language string) error {
+	return DefaultMessageCatalog.Localize(err, language)
+}
+
+// renderMessage replaces {name} placeholders with params, leaving unknown names as they are.
+func renderMessage(tmpl string, params map[string]any) string {
+	if len(params) == 0 {
+		return tmpl
+	}
+	return messagePlaceholder.ReplaceAllStringFunc(tmpl, func(m string) string {
+		if v, ok := params[m[1:len(m)-1]]; ok {
+			return fmt.Sprint(v)
+		}
+		return m
+	})
+}
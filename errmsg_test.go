@@ -0,0 +1,155 @@
+package aider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestMessageCatalog() *MessageCatalog {
+	c := NewMessageCatalog("th")
+	c.Set("USER_NOT_FOUND", map[string]string{"th": "ไม่พบผู้ใช้ {id}", "en": "user {id} not found"})
+	c.Set("NOT_FOUND", map[string]string{"th": "ไม่พบข้อมูล", "en": "not found"})
+	c.Set("THAI_ONLY", map[string]string{"th": "มีเฉพาะภาษาไทย {missing}"})
+	return c
+}
+
+func TestMessageCatalogMessage(t *testing.T) {
+	c := newTestMessageCatalog()
+	params := map[string]any{"id": 42}
+	tests := []struct {
+		name     string
+		id       string
+		language string
+		want     string
+		wantOK   bool
+	}{
+		{name: "ภาษาไทย", id: "USER_NOT_FOUND", language: "th", want: "ไม่พบผู้ใช้ 42", wantOK: true},
+		{name: "ภาษาอังกฤษ", id: "USER_NOT_FOUND", language: "en", want: "user 42 not found", wantOK: true},
+		{name: "รหัสภาษาแบบมีภูมิภาค", id: "USER_NOT_FOUND", language: "en-US", want: "user 42 not found", wantOK: true},
+		{name: "Accept-Language เลือกตาม q", id: "USER_NOT_FOUND", language: "fr;q=1, en;q=0.9, th;q=0.8", want: "user 42 not found", wantOK: true},
+		{name: "Accept-Language q=0 ไม่ใช้", id: "USER_NOT_FOUND", language: "en;q=0, th-TH;q=0.5", want: "ไม่พบผู้ใช้ 42", wantOK: true},
+		{name: "ภาษาที่ไม่มีใช้ภาษาสำรอง", id: "USER_NOT_FOUND", language: "ja", want: "ไม่พบผู้ใช้ 42", wantOK: true},
+		{name: "ข้อความไม่มีภาษาที่ขอ", id: "THAI_ONLY", language: "en", want: "มีเฉพาะภาษาไทย {missing}", wantOK: true},
+		{name: "ไม่มีข้อความนี้", id: "UNKNOWN_ID", language: "th", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := c.Message(tt.id, tt.language, params)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("Message() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMessageCatalogLocalize(t *testing.T) {
+	c := newTestMessageCatalog()
+	tests := []struct {
+		name     string
+		err      error
+		language string
+		want     string
+	}{
+		{name: "ตาม MessageID", err: &CustomError{Code: CodeNotFound, MessageID: "USER_NOT_FOUND", Details: map[string]any{"id": 7}}, language: "en", want: "user 7 not found"},
+		{name: "ตามชื่อรหัสเมื่อไม่มีข้อความ", err: NewError(ErrNotFound, ""), language: "th", want: "ไม่พบข้อมูล"},
+		{name: "ถูกห่อด้วย fmt.Errorf", err: fmt.Errorf("handler: %w", NewError(CodeNotFound, "")), language: "en-GB,en;q=0.8", want: "not found"},
+		{name: "ไม่มีในแคตตาล็อกคงข้อความเดิม", err: NewError(CodeUnavailable, "gateway down"), language: "en", want: "gateway down"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var e *CustomError
+			if !errors.As(c.Localize(tt.err, tt.language), &e) {
+				t.Fatal("Localize() did not return a CustomError")
+			}
+			if e.Message != tt.want {
+				t.Errorf("Localize() message = %q, want %q", e.Message, tt.want)
+			}
+		})
+	}
+
+	plain := errors.New("boom")
+	if got := c.Localize(plain, "en"); got != plain {
+		t.Errorf("Localize(plain) = %v, want unchanged", got)
+	}
+	orig := NewError(ErrNotFound, "")
+	c.Localize(orig, "en")
+	if orig.(*CustomError).Message != "" {
+		t.Error("Localize() should not modify the original error")
+	}
+}
+
+func TestLocalizeErrorKeepsSpecificMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		language string
+		want     string
+	}{
+		{name: "ข้อความเฉพาะไม่ถูกแทนที่", err: NewError(ErrNotFound, "user 42 not found"), language: "en", want: "user 42 not found"},
+		{name: "รหัสใหม่ก็ไม่ถูกแทนที่", err: NewError(CodeUnavailable, "gateway down"), language: "th", want: "gateway down"},
+		{name: "ไม่มีข้อความใช้ชื่อรหัส", err: NewError(CodeUnavailable, ""), language: "th", want: "ระบบไม่พร้อมให้บริการชั่วคราว"},
+		{name: "มี MessageID", err: NewMessageError(CodeNotFound, "NOT_FOUND", nil), language: "en", want: "not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var e *CustomError
+			if !errors.As(LocalizeError(tt.err, tt.language), &e) {
+				t.Fatal("LocalizeError() did not return a CustomError")
+			}
+			if e.Message != tt.want {
+				t.Errorf("LocalizeError() message = %q, want %q", e.Message, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewMessageError(t *testing.T) {
+	err := NewMessageError(CodeNotFound, "NOT_FOUND", map[string]any{"id": 1})
+	var e *CustomError
+	if !errors.As(err, &e) {
+		t.Fatal("NewMessageError() did not return a CustomError")
+	}
+	if e.Message != "ไม่พบข้อมูล" || e.MessageID != "NOT_FOUND" || e.Details["id"] != 1 {
+		t.Errorf("NewMessageError() = %+v", e)
+	}
+	if got := LocalizeError(err, "en").(*CustomError).Message; got != "not found" {
+		t.Errorf("LocalizeError() message = %q, want %q", got, "not found")
+	}
+	if got := NewMessageError(CodeInternal, "NO_SUCH_MESSAGE", nil).(*CustomError).Message; got != "NO_SUCH_MESSAGE" {
+		t.Errorf("NewMessageError() with unknown id message = %q, want the id", got)
+	}
+}
+
+func TestMessageCatalogLoad(t *testing.T) {
+	c := NewMessageCatalog("en")
+	if err := c.LoadJSON(strings.NewReader(`{"ORDER_LIMIT": {"th": "สั่งได้ไม่เกิน {max} ชิ้น", "EN": "at most {max} items"}}`)); err != nil {
+		t.Fatalf("LoadJSON() error = %v", err)
+	}
+	if got, _ := c.Message("ORDER_LIMIT", "th", map[string]any{"max": 5}); got != "สั่งได้ไม่เกิน 5 ชิ้น" {
+		t.Errorf("Message() = %q", got)
+	}
+	if got, _ := c.Message("ORDER_LIMIT", "en", map[string]any{"max": 5}); got != "at most 5 items" {
+		t.Errorf("Message() = %q", got)
+	}
+	if err := c.LoadJSON(strings.NewReader(`{"bad": "json"}`)); err == nil {
+		t.Error("LoadJSON() with invalid structure should return error")
+	}
+
+	path := filepath.Join(t.TempDir(), "messages.json")
+	if err := os.WriteFile(path, []byte(`{"HELLO": {"th": "สวัสดี {name}"}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoadFile(path); err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	if got, _ := c.Message("HELLO", "th", map[string]any{"name": "สมชาย"}); got != "สวัสดี สมชาย" {
+		t.Errorf("Message() = %q", got)
+	}
+	if err := c.LoadFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("LoadFile() with missing file should return error")
+	}
+}
@@ -0,0 +1,406 @@
+package aider
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Holiday ข้อมูลวันหยุด 1 วัน
+type Holiday struct {
+	Date       time.Time // วันที่ (เวลา 00:00:00 ตามโซนเวลาไทย)
+	Name       string    // ชื่อวันหยุด
+	Substitute bool      // เป็นวันหยุดชดเชยหรือไม่
+}
+
+// วันหยุดราชการไทยที่ตรงกับวันที่เดิมทุกปี
+type fixedHoliday struct {
+	month time.Month
+	day   int
+	name  string
+	since int // ปี ค.ศ. ที่เริ่มมีวันหยุดนี้ (0 = ทุกปี)
+}
+
+var thaiFixedHolidays = []fixedHoliday{
+	{time.January, 1, "วันขึ้นปีใหม่", 0},
+	{time.April, 6, "วันจักรี", 0},
+	{time.April, 13, "วันสงกรานต์", 0},
+	{time.April, 14, "วันสงกรานต์", 0},
+	{time.April, 15, "วันสงกรานต์", 0},
+	{time.May, 1, "วันแรงงานแห่งชาติ", 0},
+	{time.May, 4, "วันฉัตรมงคล", 2020},
+	{time.June, 3, "วันเฉลิมพระชนมพรรษาสมเด็จพระนางเจ้าฯ พระบรมราชินี", 2019},
+	{time.July, 28, "วันเฉลิมพระชนมพรรษาพระบาทสมเด็จพระเจ้าอยู่หัว", 2017},
+	{time.August, 12, "วันแม่แห่งชาติ", 0},
+	{time.October, 13, "วันนวมินทรมหาราช", 2017},
+	{time.October, 23, "วันปิยมหาราช", 0},
+	{time.December, 5, "วันพ่อแห่งชาติ", 0},
+	{time.December, 10, "วันรัฐธรรมนูญ", 0},
+	{time.December, 31, "วันสิ้นปี", 0},
+}
+
+// HolidayCalendar ปฏิทินวันหยุด ใช้ร่วมกับฟังก์ชันนับวันทำการ
+// ปลอดภัยสำหรับการใช้งานพร้อมกันหลาย goroutine
+type HolidayCalendar struct {
+	mu          sync.Mutex
+	thai        bool               // สร้างวันหยุดราชการไทยแบบวันที่คงที่ให้อัตโนมัติ
+	baseYears   map[int]bool       // ปีที่สร้างวันหยุดคงที่แล้ว
+	subYears    map[int]bool       // ปีที่คำนวณวันหยุดชดเชยแล้ว
+	substituted map[string]bool    // วันหยุดที่ได้รับวันชดเชยไปแล้ว (key = วันที่ของวันหยุดเดิม)
+	holidays    map[string]Holiday // key = "2006-01-02"
+}
+
+// NewHolidayCalendar สร้างปฏิทินวันหยุดเปล่า (มีเฉพาะวันหยุดที่เพิ่มหรือโหลดเข้าไปเอง)
+func NewHolidayCalendar() *HolidayCalendar {
+	return &HolidayCalendar{
+		baseYears:   make(map[int]bool),
+		subYears:    make(map[int]bool),
+		substituted: make(map[string]bool),
+		holidays:    make(map[string]Holiday),
+	}
+}
+
+// NewThaiHolidayCalendar สร้างปฏิทินวันหยุดราชการไทยที่ตรงกับวันที่เดิมทุกปี
+// พร้อมวันหยุดชดเชยเมื่อวันหยุดตรงกับวันเสาร์หรืออาทิตย์
+// วันหยุดทางจันทรคติเพิ่มได้จาก ThaiLunarHolidays ส่วนวันหยุดพิเศษที่ประกาศเพิ่ม ให้โหลดด้วย LoadJSON หรือ LoadICS
+func NewThaiHolidayCalendar() *HolidayCalendar {
+	c := NewHolidayCalendar()
+	c.thai = true
+	return c
+}
+
+// Add เพิ่มวันหยุด โดยใช้ปี/เดือน/วันของ date ตามที่ระบุ
+func (c *HolidayCalendar) Add(date time.Time, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.add(Holiday{Date: civilMidnight(date), Name: name})
+}
+
+// IsHoliday ตรวจสอบว่าวันที่ของ t (ตามโซนเวลาไทย) เป็นวันหยุดหรือไม่
+func (c *HolidayCalendar) IsHoliday(t time.Time) bool {
+	_, ok := c.Holiday(t)
+	return ok
+}
+
+// Holiday คืนข้อมูลวันหยุดของวันที่ t (ตามโซนเวลาไทย)
+func (c *HolidayCalendar) Holiday(t time.Time) (Holiday, bool) {
+	t = t.In(loadLocation())
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ensureYear(t.Year())
+	h, ok := c.holidays[t.Format(dateLayout)]
+	return h, ok
+}
+
+// Holidays คืนรายการวันหยุดทั้งหมดของปี ค.ศ. ที่กำหนด เรียงตามวันที่
+func (c *HolidayCalendar) Holidays(year int) []Holiday {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ensureYear(year)
+
+	var result []Holiday
+	for _, h := range c.holidays {
+		if h.Date.Year() == year {
+			result = append(result, h)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Date.Before(result[j].Date)
+	})
+	return result
+}
+
+// LoadJSON โหลดวันหยุดจาก JSON ในรูปแบบ [{"date": "2025-05-12", "name": "วันวิสาขบูชา"}]
+func (c *HolidayCalendar) LoadJSON(r io.Reader) error {
+	var items []struct {
+		Date       string `json:"date"`
+		Name       string `json:"name"`
+		Substitute bool   `json:"substitute"`
+	}
+	if err := json.NewDecoder(r).Decode(&items); err != nil {
+		return err
+	}
+
+	holidays := make([]Holiday, 0, len(items))
+	for _, item := range items {
+		d, err := time.ParseInLocation(dateLayout, item.Date, loadLocation())
+		if err != nil {
+			return fmt.Errorf("invalid holiday date %q: %w", item.Date, err)
+		}
+		holidays = append(holidays, Holiday{Date: d, Name: item.Name, Substitute: item.Substitute})
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, h := range holidays {
+		c.add(h)
+	}
+	return nil
+}
+
+// LoadJSONFile โหลดวันหยุดจากไฟล์ JSON (ดูรูปแบบที่ LoadJSON)
+func (c *HolidayCalendar) LoadJSONFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return c.LoadJSON(f)
+}
+
+// LoadICS โหลดวันหยุดจากไฟล์ปฏิทิน iCalendar (.ics) โดยอ่าน DTSTART, DTEND และ SUMMARY ของแต่ละ VEVENT
+// กรณีที่มี DTEND แบบวันที่ จะถือว่าเป็นวันหยุดต่อเนื่องจนถึงวันก่อน DTEND (ตามมาตรฐาน iCalendar)
+func (c *HolidayCalendar) LoadICS(r io.Reader) error {
+	lines, err := unfoldICSLines(r)
+	if err != nil {
+		return err
+	}
+
+	var holidays []Holiday
+	var inEvent bool
+	var name string
+	var start, end time.Time
+	for _, line := range lines {
+		switch {
+		case line == "BEGIN:VEVENT":
+			inEvent = true
+			name, start, end = "", time.Time{}, time.Time{}
+		case line == "END:VEVENT":
+			inEvent = false
+			if start.IsZero() {
+				return fmt.Errorf("invalid ics event %q: missing DTSTART", name)
+			}
+			if end.IsZero() || !end.After(start) {
+				end = start.AddDate(0, 0, 1)
+			}
+			for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+				holidays = append(holidays, Holiday{Date: d, Name: name})
+			}
+		case inEvent:
+			key, value, ok := strings.Cut(line, ":")
+			if !ok {
+				continue
+			}
+			prop, _, _ := strings.Cut(key, ";")
+			switch strings.ToUpper(prop) {
+			case "SUMMARY":
+				name = unescapeICSText(value)
+			case "DTSTART":
+				if start, err = parseICSDate(value); err != nil {
+					return err
+				}
+			case "DTEND":
+				if end, err = parseICSDate(value); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, h := range holidays {
+		c.add(h)
+	}
+	return nil
+}
+
+// LoadICSFile โหลดวันหยุดจากไฟล์ .ics (ดูรายละเอียดที่ LoadICS)
+func (c *HolidayCalendar) LoadICSFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return c.LoadICS(f)
+}
+
+func (c *HolidayCalendar) add(h Holiday) {
+	key := h.Date.Format(dateLayout)
+	old, ok := c.holidays[key]
+	c.holidays[key] = h
+	// วันหยุดที่เพิ่มเข้ามาทับวันหยุดชดเชย ให้เลื่อนวันหยุดชดเชยไปวันถัดไป
+	if ok && old.Substitute && !h.Substitute {
+		c.addSubstitute(old.Date, strings.TrimPrefix(old.Name, "ชดเชย"))
+	}
+}
+
+// เพิ่มวันหยุดชดเชยในวันทำการแรกหลังจาก from
+func (c *HolidayCalendar) addSubstitute(from time.Time, name string) {
+	d := from.AddDate(0, 0, 1)
+	for isWeekend(d) || c.hasHoliday(d) {
+		d = d.AddDate(0, 0, 1)
+	}
+	c.holidays[d.Format(dateLayout)] = Holiday{Date: d, Name: "ชดเชย" + name, Substitute: true}
+}
+
+// สร้างวันหยุดคงที่และวันหยุดชดเชยของปีที่ต้องการ (ต้องถือ lock อยู่)
+func (c *HolidayCalendar) ensureYear(year int) {
+	if !c.thai || c.subYears[year] {
+		return
+	}
+	for y := year - 1; y <= year+1; y++ {
+		c.ensureBaseYear(y)
+	}
+
+	// วันหยุดชดเชยของเดือนธันวาคมปีก่อนอาจตกมาอยู่ในต้นปีนี้ จึงคำนวณรวมวันหยุดเดือนธันวาคมของปีก่อนด้วย
+	loc := loadLocation()
+	for y := year - 1; y <= year; y++ {
+		for _, f := range thaiFixedHolidays {
+			if f.since > y || (y < year && f.month != time.December) {
+				continue
+			}
+			d := time.Date(y, f.month, f.day, 0, 0, 0, 0, loc)
+			key := d.Format(dateLayout)
+			if !isWeekend(d) || c.substituted[key] {
+				continue
+			}
+			c.addSubstitute(d, f.name)
+			c.substituted[key] = true
+		}
+	}
+	c.subYears[year] = true
+}
+
+func (c *HolidayCalendar) ensureBaseYear(year int) {
+	if c.baseYears[year] {
+		return
+	}
+	loc := loadLocation()
+	for _, f := range thaiFixedHolidays {
+		if f.since > year {
+			continue
+		}
+		d := time.Date(year, f.month, f.day, 0, 0, 0, 0, loc)
+		// ไม่เขียนทับวันหยุดที่เพิ่มหรือโหลดเข้ามาเอง
+		if !c.hasHoliday(d) {
+			c.add(Holiday{Date: d, Name: f.name})
+		}
+	}
+	c.baseYears[year] = true
+}
+
+func (c *HolidayCalendar) hasHoliday(d time.Time) bool {
+	_, ok := c.holidays[d.Format(dateLayout)]
+	return ok
+}
+
+// IsBusinessDay ตรวจสอบว่าวันที่ของ t (ตามโซนเวลาไทย) เป็นวันทำการหรือไม่
+// คือไม่ใช่วันเสาร์ อาทิตย์ และไม่เป็นวันหยุดใน cal (ถ้า cal เป็น nil จะพิจารณาเฉพาะวันเสาร์-อาทิตย์)
+func IsBusinessDay(t time.Time, cal *HolidayCalendar) bool {
+	t = t.In(loadLocation())
+	if isWeekend(t) {
+		return false
+	}
+	return cal == nil || !cal.IsHoliday(t)
+}
+
+// NextBusinessDay คืนวันทำการถัดไปหลังจากวันที่ของ t (เวลา 00:00:00 ตามโซนเวลาไทย)
+func NextBusinessDay(t time.Time, cal *HolidayCalendar) time.Time {
+	d := civilMidnight(t.In(loadLocation())).AddDate(0, 0, 1)
+	for !IsBusinessDay(d, cal) {
+		d = d.AddDate(0, 0, 1)
+	}
+	return d
+}
+
+// PreviousBusinessDay คืนวันทำการก่อนหน้าวันที่ของ t (เวลา 00:00:00 ตามโซนเวลาไทย)
+func PreviousBusinessDay(t time.Time, cal *HolidayCalendar) time.Time {
+	d := civilMidnight(t.In(loadLocation())).AddDate(0, 0, -1)
+	for !IsBusinessDay(d, cal) {
+		d = d.AddDate(0, 0, -1)
+	}
+	return d
+}
+
+// AddBusinessDays บวก (หรือลบ ถ้า n ติดลบ) จำนวนวันทำการให้กับ t โดยคงเวลาของวันไว้
+func AddBusinessDays(t time.Time, n int, cal *HolidayCalendar) time.Time {
+	t = t.In(loadLocation())
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if IsBusinessDay(t, cal) {
+			n--
+		}
+	}
+	return t
+
+	/*
+		Ex.
+		cal := NewThaiHolidayCalendar()
+		start := time.Date(2025, 4, 11, 9, 0, 0, 0, loadLocation()) // ศุกร์ก่อนสงกรานต์
+		AddBusinessDays(start, 1, cal) // 2025-04-17 09:00:00 +0700 (ข้าม ส-อา, 13-15 เม.ย. และวันหยุดชดเชย 16 เม.ย.)
+	*/
+}
+
+// CountBusinessDays นับจำนวนวันทำการระหว่าง start ถึง end (นับรวมวันแรกและวันสุดท้าย)
+// คืนค่า 0 ถ้า end อยู่ก่อน start
+func CountBusinessDays(start, end time.Time, cal *HolidayCalendar) int {
+	start = civilMidnight(start.In(loadLocation()))
+	end = civilMidnight(end.In(loadLocation()))
+
+	var count int
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if IsBusinessDay(d, cal) {
+			count++
+		}
+	}
+	return count
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
+
+// คืนเวลา 00:00:00 ของปี/เดือน/วันของ t ในโซนเวลาไทย
+func civilMidnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loadLocation())
+}
+
+// อ่านบรรทัดของไฟล์ ics และรวมบรรทัดที่ถูกพับ (ขึ้นต้นด้วยช่องว่างหรือ tab) เข้ากับบรรทัดก่อนหน้า
+func unfoldICSLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// แปลงวันที่ใน ics (20250512 หรือ 20250512T000000Z) เป็นเวลา 00:00:00 ตามโซนเวลาไทย
+func parseICSDate(value string) (time.Time, error) {
+	loc := loadLocation()
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid ics date %q: %w", value, err)
+		}
+		return civilMidnight(t.In(loc)), nil
+	}
+	if len(value) > 8 {
+		value = value[:8]
+	}
+	t, err := time.ParseInLocation("20060102", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid ics date %q: %w", value, err)
+	}
+	return t, nil
+}
+
+func unescapeICSText(s string) string {
+	return strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`).Replace(s)
+}
@@ -0,0 +1,95 @@
+package aider
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHolidayCalendarSubstitute(t *testing.T) {
+	loc := loadLocation()
+	cal := NewThaiHolidayCalendar()
+	tests := []struct {
+		name           string
+		date           time.Time
+		wantHoliday    bool
+		wantSubstitute bool
+	}{
+		{name: "วันฉัตรมงคลตรงวันอาทิตย์", date: time.Date(2025, 5, 4, 0, 0, 0, 0, loc), wantHoliday: true},
+		{name: "ชดเชยวันฉัตรมงคล", date: time.Date(2025, 5, 5, 0, 0, 0, 0, loc), wantHoliday: true, wantSubstitute: true},
+		{name: "ชดเชยสงกรานต์วันแรก", date: time.Date(2024, 4, 16, 0, 0, 0, 0, loc), wantHoliday: true, wantSubstitute: true},
+		{name: "ชดเชยสงกรานต์วันที่สอง", date: time.Date(2024, 4, 17, 0, 0, 0, 0, loc), wantHoliday: true, wantSubstitute: true},
+		{name: "ชดเชยวันสิ้นปีข้ามปี", date: time.Date(2023, 1, 2, 0, 0, 0, 0, loc), wantHoliday: true, wantSubstitute: true},
+		{name: "ชดเชยวันขึ้นปีใหม่", date: time.Date(2023, 1, 3, 0, 0, 0, 0, loc), wantHoliday: true, wantSubstitute: true},
+		{name: "วันทำการปกติ", date: time.Date(2023, 1, 4, 0, 0, 0, 0, loc), wantHoliday: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, ok := cal.Holiday(tt.date)
+			if ok != tt.wantHoliday {
+				t.Fatalf("Holiday() ok = %v, want %v", ok, tt.wantHoliday)
+			}
+			if h.Substitute != tt.wantSubstitute {
+				t.Errorf("Holiday() substitute = %v, want %v (%s)", h.Substitute, tt.wantSubstitute, h.Name)
+			}
+		})
+	}
+}
+
+func TestHolidayCalendarLoad(t *testing.T) {
+	cal := NewHolidayCalendar()
+	err := cal.LoadJSON(strings.NewReader(`[{"date": "2025-05-12", "name": "วันวิสาขบูชา"}]`))
+	if err != nil {
+		t.Fatalf("LoadJSON() error = %v", err)
+	}
+
+	ics := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20250710\r\nDTEND;VALUE=DATE:20250712\r\nSUMMARY:วันอาสาฬหบูชา\\, \r\n วันเข้าพรรษา\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+	if err := cal.LoadICS(strings.NewReader(ics)); err != nil {
+		t.Fatalf("LoadICS() error = %v", err)
+	}
+
+	got := cal.Holidays(2025)
+	if len(got) != 3 {
+		t.Fatalf("Holidays() = %v, want 3 holidays", got)
+	}
+	if got[1].Name != "วันอาสาฬหบูชา, วันเข้าพรรษา" {
+		t.Errorf("Holidays()[1].Name = %q", got[1].Name)
+	}
+
+	if err := cal.LoadJSON(strings.NewReader(`[{"date": "12/05/2025", "name": "x"}]`)); err == nil {
+		t.Errorf("LoadJSON() expected error for invalid date")
+	}
+}
+
+func TestBusinessDays(t *testing.T) {
+	loc := loadLocation()
+	cal := NewThaiHolidayCalendar()
+
+	start := time.Date(2025, 4, 11, 9, 0, 0, 0, loc) // วันศุกร์ก่อนสงกรานต์
+	// 13 เม.ย. 2568 ตรงกับวันอาทิตย์ จึงมีวันหยุดชดเชยวันที่ 16 เม.ย.
+	if got, want := AddBusinessDays(start, 1, cal), time.Date(2025, 4, 17, 9, 0, 0, 0, loc); !got.Equal(want) {
+		t.Errorf("AddBusinessDays() = %v, want %v", got, want)
+	}
+	if got, want := AddBusinessDays(time.Date(2025, 4, 17, 9, 0, 0, 0, loc), -1, cal), start; !got.Equal(want) {
+		t.Errorf("AddBusinessDays() negative = %v, want %v", got, want)
+	}
+	if got, want := NextBusinessDay(start, cal), time.Date(2025, 4, 17, 0, 0, 0, 0, loc); !got.Equal(want) {
+		t.Errorf("NextBusinessDay() = %v, want %v", got, want)
+	}
+	if got, want := PreviousBusinessDay(time.Date(2025, 4, 17, 0, 0, 0, 0, loc), cal), time.Date(2025, 4, 11, 0, 0, 0, 0, loc); !got.Equal(want) {
+		t.Errorf("PreviousBusinessDay() = %v, want %v", got, want)
+	}
+
+	// 1-30 เมษายน 2568 มี 22 วันธรรมดา หักวันหยุด 7 เม.ย. (ชดเชยวันจักรี) 14, 15 เม.ย. และ 16 เม.ย. (ชดเชยสงกรานต์)
+	from := time.Date(2025, 4, 1, 0, 0, 0, 0, loc)
+	to := time.Date(2025, 4, 30, 0, 0, 0, 0, loc)
+	if got := CountBusinessDays(from, to, cal); got != 18 {
+		t.Errorf("CountBusinessDays() = %v, want 18", got)
+	}
+	if got := CountBusinessDays(from, to, nil); got != 22 {
+		t.Errorf("CountBusinessDays() without calendar = %v, want 22", got)
+	}
+	if IsBusinessDay(time.Date(2025, 4, 12, 10, 0, 0, 0, loc), nil) {
+		t.Errorf("IsBusinessDay() saturday = true")
+	}
+}
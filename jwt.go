@@ -2,7 +2,6 @@ package aider
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
@@ -47,7 +46,7 @@ func VerifyJWT(jwtKey []byte, tokenString string) (*jwt.RegisteredClaims, error)
 			return nil, fmt.Errorf("unexpected signing method")
 		}
 		return jwtKey, nil
-	})
+	}, jwt.WithTimeFunc(now)) // ตรวจ exp/nbf ด้วยนาฬิกาของแพ็กเกจ (เปลี่ยนได้ด้วย SetClock)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +57,10 @@ func VerifyJWT(jwtKey []byte, tokenString string) (*jwt.RegisteredClaims, error)
 	}
 
 	// ตรวจสอบ Expiration และ Issuer
-	if claims.ExpiresAt.Before(time.Now()) {
+	if claims.ExpiresAt == nil {
+		return nil, fmt.Errorf("token has no expiration")
+	}
+	if claims.ExpiresAt.Before(now()) {
 		return nil, fmt.Errorf("token expired")
 	}
 
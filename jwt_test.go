@@ -0,0 +1,48 @@
+package aider
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestVerifyJWTUsesClock(t *testing.T) {
+	key := []byte("secret")
+	start := time.Date(2025, 2, 21, 9, 0, 0, 0, time.UTC)
+	fake := NewFakeClock(start)
+	SetClock(fake)
+	defer SetClock(nil)
+
+	sign := func(claims jwt.RegisteredClaims) string {
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(key)
+		if err != nil {
+			t.Fatalf("SignedString() error = %v", err)
+		}
+		return s
+	}
+	token := sign(jwt.RegisteredClaims{
+		Issuer:    "your-issuer222",
+		Audience:  jwt.ClaimStrings{"your-audience111"},
+		IssuedAt:  jwt.NewNumericDate(start),
+		ExpiresAt: jwt.NewNumericDate(start.Add(15 * time.Minute)),
+	})
+
+	if _, err := VerifyJWT(key, token); err != nil {
+		t.Fatalf("VerifyJWT() before expiry error = %v", err)
+	}
+
+	fake.Advance(16 * time.Minute)
+	if _, err := VerifyJWT(key, token); err == nil || !strings.Contains(err.Error(), "expired") {
+		t.Errorf("VerifyJWT() after expiry error = %v, want token expired", err)
+	}
+
+	noExp := sign(jwt.RegisteredClaims{
+		Issuer:   "your-issuer222",
+		Audience: jwt.ClaimStrings{"your-audience111"},
+	})
+	if _, err := VerifyJWT(key, noExp); err == nil {
+		t.Error("VerifyJWT() without exp should return error")
+	}
+}
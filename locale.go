@@ -0,0 +1,186 @@
+package aider
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidLanguage error เมื่อระบุภาษา (locale) ที่ไม่ได้ลงทะเบียนไว้
+var ErrInvalidLanguage = errors.New("invalid language")
+
+// Locale ข้อมูลภาษาที่ใช้จัดรูปแบบวันที่ ลงทะเบียนเพิ่มได้ด้วย RegisterLocale
+type Locale struct {
+	Code          string   // รหัสภาษา เช่น "th", "en", "lo"
+	Months        []string // ชื่อเดือนเต็ม 12 เดือน
+	MonthsShort   []string // ชื่อเดือนย่อ 12 เดือน
+	Weekdays      []string // ชื่อวันเต็ม 7 วัน เรียงตาม time.Weekday (Sunday = 0)
+	WeekdaysShort []string // ชื่อวันย่อ 7 วัน เรียงตาม time.Weekday
+	EraOffset     int      // ผลต่างของปีที่แสดงด้วย token EEEE จากปี ค.ศ. เช่น พ.ศ. = 543
+	Digits        []rune   // ตัวเลขประจำภาษา 0-9 (ว่างได้)
+	NativeDigits  bool     // แสดงตัวเลขด้วย Digits แทนเลขอารบิก
+
+	// layout ของ FormatThai ที่ใช้ในแต่ละฟังก์ชัน ค่าว่างจะใช้ค่าเริ่มต้นตามที่ระบุ
+	ShortDateLayout      string // ShortDate ค่าเริ่มต้น "DD MMM EEEE"
+	ShortMonthLayout     string // ShortMonth ค่าเริ่มต้น "MMM"
+	ShortYearMonthLayout string // ShortYearMonth ค่าเริ่มต้น "MMM EEEE"
+	LongDateLayout       string // LongDate และ FormatDate ค่าเริ่มต้น "DD MMMM EEEE"
+}
+
+var (
+	localeMu sync.RWMutex
+	locales  = map[string]Locale{}
+)
+
+func init() {
+	englishMonths := make([]string, 12)
+	englishMonthsShort := make([]string, 12)
+	for i := range englishMonths {
+		englishMonths[i] = time.Month(i + 1).String()
+		englishMonthsShort[i] = englishMonths[i][:3]
+	}
+	englishWeekdays := make([]string, 7)
+	englishWeekdaysShort := make([]string, 7)
+	for i := range englishWeekdays {
+		englishWeekdays[i] = time.Weekday(i).String()
+		englishWeekdaysShort[i] = englishWeekdays[i][:3]
+	}
+
+	for _, l := range []Locale{
+		{
+			Code:                 languageTh,
+			Months:               thaiMonthsFull,
+			MonthsShort:          thaiMonths,
+			Weekdays:             thaiWeekdays,
+			WeekdaysShort:        thaiWeekdaysShort,
+			EraOffset:            buddhistEraOffset,
+			Digits:               thaiDigits,
+			ShortDateLayout:      "DD MMM EEEE",
+			ShortMonthLayout:     "MMM",
+			ShortYearMonthLayout: "MMM YYYY",
+			LongDateLayout:       "DD MMMM EEEE",
+		},
+		{
+			Code:                 "en",
+			Months:               englishMonths,
+			MonthsShort:          englishMonthsShort,
+			Weekdays:             englishWeekdays,
+			WeekdaysShort:        englishWeekdaysShort,
+			ShortDateLayout:      "DD MMM. EEEE",
+			ShortMonthLayout:     "MMM.",
+			ShortYearMonthLayout: "MMM. EEEE",
+			LongDateLayout:       "DD MMMM EEEE",
+		},
+	} {
+		if err := RegisterLocale(l); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterLocale ลงทะเบียนภาษาใหม่ หรือแทนที่ภาษาที่มีรหัสเดียวกัน
+func RegisterLocale(l Locale) error {
+	switch {
+	case strings.TrimSpace(l.Code) == "":
+		return errors.New("register locale: empty code")
+	case len(l.Months) != 12 || len(l.MonthsShort) != 12:
+		return fmt.Errorf("register locale %q: expected 12 month names", l.Code)
+	case len(l.Weekdays) != 7 || len(l.WeekdaysShort) != 7:
+		return fmt.Errorf("register locale %q: expected 7 weekday names", l.Code)
+	case len(l.Digits) != 0 && len(l.Digits) != 10:
+		return fmt.Errorf("register locale %q: expected 10 digits", l.Code)
+	case l.NativeDigits && len(l.Digits) == 0:
+		return fmt.Errorf("register locale %q: native digits without digits", l.Code)
+	}
+	if l.ShortDateLayout == "" {
+		l.ShortDateLayout = "DD MMM EEEE"
+	}
+	if l.ShortMonthLayout == "" {
+		l.ShortMonthLayout = "MMM"
+	}
+	if l.ShortYearMonthLayout == "" {
+		l.ShortYearMonthLayout = "MMM EEEE"
+	}
+	if l.LongDateLayout == "" {
+		l.LongDateLayout = "DD MMMM EEEE"
+	}
+
+	localeMu.Lock()
+	defer localeMu.Unlock()
+	locales[l.Code] = l.clone()
+	return nil
+
+	/*
+		Ex.
+		err := RegisterLocale(Locale{
+			Code:          "lo",
+			Months:        []string{"ມັງກອນ", "ກຸມພາ", "ມີນາ", "ເມສາ", "ພຶດສະພາ", "ມິຖຸນາ", "ກໍລະກົດ", "ສິງຫາ", "ກັນຍາ", "ຕຸລາ", "ພະຈິກ", "ທັນວາ"},
+			MonthsShort:   ...,
+			Weekdays:      ...,
+			WeekdaysShort: ...,
+			EraOffset:     543,
+		})
+		FormatShortDate(t, "lo") // 21 ກຸມພາ 2568
+	*/
+}
+
+// LookupLocale คืนสำเนาข้อมูลภาษาที่ลงทะเบียนไว้ (แก้ไขผลลัพธ์ได้โดยไม่กระทบภาษาที่ลงทะเบียน)
+// ถ้าไม่พบจะคืน error ที่ตรวจด้วย errors.Is(err, ErrInvalidLanguage) ได้
+func LookupLocale(code string) (Locale, error) {
+	l, err := lookupLocale(code)
+	if err != nil {
+		return Locale{}, err
+	}
+	return l.clone(), nil
+}
+
+// lookupLocale เหมือน LookupLocale แต่ไม่คัดลอก slice ใช้ภายในแพ็กเกจที่อ่านอย่างเดียวเท่านั้น
+func lookupLocale(code string) (Locale, error) {
+	localeMu.RLock()
+	defer localeMu.RUnlock()
+	l, ok := locales[code]
+	if !ok {
+		return Locale{}, fmt.Errorf("%w %q", ErrInvalidLanguage, code)
+	}
+	return l, nil
+}
+
+// Locales คืนรหัสภาษาทั้งหมดที่ลงทะเบียนไว้ เรียงตามตัวอักษร
+func Locales() []string {
+	localeMu.RLock()
+	defer localeMu.RUnlock()
+	codes := make([]string, 0, len(locales))
+	for code := range locales {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// สำเนาของ l ที่ไม่ใช้ slice ร่วมกับต้นฉบับ
+func (l Locale) clone() Locale {
+	l.Months = append([]string(nil), l.Months...)
+	l.MonthsShort = append([]string(nil), l.MonthsShort...)
+	l.Weekdays = append([]string(nil), l.Weekdays...)
+	l.WeekdaysShort = append([]string(nil), l.WeekdaysShort...)
+	if l.Digits != nil {
+		l.Digits = append([]rune(nil), l.Digits...)
+	}
+	return l
+}
+
+// แปลงเลขอารบิกเป็นตัวเลขของภาษา ถ้าภาษาไม่ได้กำหนดให้ใช้ตัวเลขประจำภาษาจะคืนข้อความเดิม
+func (l Locale) localizeDigits(s string) string {
+	if !l.NativeDigits {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return l.Digits[r-'0']
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,74 @@
+package aider
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRegisterLocale(t *testing.T) {
+	months := []string{"ມັງກອນ", "ກຸມພາ", "ມີນາ", "ເມສາ", "ພຶດສະພາ", "ມິຖຸນາ", "ກໍລະກົດ", "ສິງຫາ", "ກັນຍາ", "ຕຸລາ", "ພະຈິກ", "ທັນວາ"}
+	err := RegisterLocale(Locale{
+		Code:          "lo",
+		Months:        months,
+		MonthsShort:   months,
+		Weekdays:      []string{"ອາທິດ", "ຈັນ", "ອັງຄານ", "ພຸດ", "ພະຫັດ", "ສຸກ", "ເສົາ"},
+		WeekdaysShort: []string{"ອາ", "ຈ", "ອ", "ພ", "ພຫ", "ສກ", "ສ"},
+		EraOffset:     543,
+		Digits:        []rune("໐໑໒໓໔໕໖໗໘໙"),
+		NativeDigits:  true,
+	})
+	if err != nil {
+		t.Fatalf("RegisterLocale() error = %v", err)
+	}
+
+	date := time.Date(2025, time.February, 21, 0, 0, 0, 0, time.UTC)
+	if got, err := FormatShortDate(date, "lo"); err != nil || got != "໒໑ ກຸມພາ ໒໕໖໘" {
+		t.Errorf("FormatShortDate() = %q, %v", got, err)
+	}
+	if got, err := FormatThai(date, "dddd D MMMM YYYY", "lo"); err != nil || got != "ສຸກ ໒໑ ກຸມພາ ໒໐໒໕" {
+		t.Errorf("FormatThai() = %q, %v", got, err)
+	}
+	if got, err := LongDate(date, "th"); err != nil || got != "21 กุมภาพันธ์ 2568" {
+		t.Errorf("LongDate() = %q, %v", got, err)
+	}
+
+	if _, err := FormatShortMonth(date, "km"); !errors.Is(err, ErrInvalidLanguage) {
+		t.Errorf("FormatShortMonth() error = %v, want ErrInvalidLanguage", err)
+	}
+	if got := ShortMonth(date, "km"); got != "invalid language" {
+		t.Errorf("ShortMonth() = %q", got)
+	}
+	if err := RegisterLocale(Locale{Code: "my", Months: months[:11]}); err == nil {
+		t.Errorf("RegisterLocale() expected error for missing month names")
+	}
+}
+
+func TestLookupLocaleReturnsCopy(t *testing.T) {
+	l, err := LookupLocale("th")
+	if err != nil {
+		t.Fatalf("LookupLocale() error = %v", err)
+	}
+	l.Months[0] = "x"
+	l.MonthsShort[0] = "x"
+	l.Weekdays[0] = "x"
+	l.Digits[0] = 'x'
+
+	if got, _ := ToThaiMonth(1); got != "ม.ค." {
+		t.Errorf("ToThaiMonth(1) = %q after mutating lookup result, want %q", got, "ม.ค.")
+	}
+	if again, _ := LookupLocale("th"); again.Months[0] != "มกราคม" || again.Weekdays[0] != "อาทิตย์" {
+		t.Errorf("registered locale changed: %q %q", again.Months[0], again.Weekdays[0])
+	}
+
+	months := make([]string, 12)
+	copy(months, l.Months)
+	months[0] = "Jan"
+	if err := RegisterLocale(Locale{Code: "copy-test", Months: months, MonthsShort: months, Weekdays: l.Weekdays, WeekdaysShort: l.WeekdaysShort}); err != nil {
+		t.Fatalf("RegisterLocale() error = %v", err)
+	}
+	months[0] = "changed"
+	if got, _ := FormatThai(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "MMMM", "copy-test"); got != "Jan" {
+		t.Errorf("FormatThai() = %q after mutating registered slice, want %q", got, "Jan")
+	}
+}
@@ -0,0 +1,160 @@
+package aider
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	defaultLocationName   = "Asia/Bangkok"
+	defaultLocationOffset = 7 * 60 * 60 // +07:00
+)
+
+var (
+	locOnce     sync.Once
+	locMu       sync.RWMutex
+	location    *time.Location
+	locationErr error
+)
+
+// LoadLocationOrFixed โหลดโซนเวลาตามชื่อ ถ้าโหลดไม่ได้ (เช่น container ที่ไม่มี zoneinfo)
+// จะคืนโซนเวลาแบบคงที่ตาม offsetSeconds พร้อม error ที่เกิดขึ้น จึงไม่มีทางได้ location เป็น nil
+// หากต้องการฝัง tzdata ไว้ในไบนารี ให้ build ด้วย tag aider_tzdata หรือ import _ "time/tzdata" เอง
+func LoadLocationOrFixed(name string, offsetSeconds int) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.FixedZone(offsetLabel(offsetSeconds), offsetSeconds), fmt.Errorf("load location %q: %w", name, err)
+	}
+	return loc, nil
+}
+
+// loadLocation คืนโซนเวลาที่แพ็กเกจใช้อยู่ (ค่าเริ่มต้น Asia/Bangkok)
+func loadLocation() *time.Location {
+	locOnce.Do(func() {
+		location, locationErr = LoadLocationOrFixed(defaultLocationName, defaultLocationOffset)
+	})
+	locMu.RLock()
+	defer locMu.RUnlock()
+	return location
+}
+
+// Location คืนโซนเวลาที่แพ็กเกจใช้อยู่ (ค่าเริ่มต้น Asia/Bangkok)
+func Location() *time.Location {
+	return loadLocation()
+}
+
+// LocationError คืน error ที่เกิดจากการโหลดโซนเวลาเริ่มต้น
+// ถ้าไม่เป็น nil แปลว่าแพ็กเกจกำลังใช้โซนเวลาคงที่ +07:00 แทน Asia/Bangkok
+func LocationError() error {
+	loadLocation()
+	locMu.RLock()
+	defer locMu.RUnlock()
+	return locationErr
+}
+
+// SetLocation กำหนดโซนเวลาที่ใช้ทั้งแพ็กเกจ ถ้าส่ง nil จะกลับไปใช้ Asia/Bangkok
+func SetLocation(loc *time.Location) {
+	loadLocation()
+	var err error
+	if loc == nil {
+		loc, err = LoadLocationOrFixed(defaultLocationName, defaultLocationOffset)
+	}
+	locMu.Lock()
+	defer locMu.Unlock()
+	location, locationErr = loc, err
+}
+
+// SetLocationName กำหนดโซนเวลาที่ใช้ทั้งแพ็กเกจจากชื่อ เช่น "Asia/Ho_Chi_Minh"
+// ถ้าโหลดไม่ได้จะคืน error และไม่เปลี่ยนโซนเวลาเดิม
+func SetLocationName(name string) error {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return fmt.Errorf("load location %q: %w", name, err)
+	}
+	SetLocation(loc)
+	return nil
+}
+
+// ชื่อโซนเวลาแบบคงที่ เช่น +07 หรือ +0530
+func offsetLabel(offsetSeconds int) string {
+	sign := '+'
+	if offsetSeconds < 0 {
+		sign, offsetSeconds = '-', -offsetSeconds
+	}
+	h, m := offsetSeconds/3600, offsetSeconds%3600/60
+	if m == 0 {
+		return fmt.Sprintf("%c%02d", sign, h)
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, h, m)
+}
+
+// GetTodayIn วันที่ปัจจุบัน เวลา 00:00:00 ตามโซนเวลาที่กำหนด
+func GetTodayIn(loc *time.Location) time.Time {
+	return startOfDayOffset(now(), loc, 0)
+}
+
+// GetYesterdayIn วันที่เมื่อวาน เวลา 00:00:00 ตามโซนเวลาที่กำหนด
+func GetYesterdayIn(loc *time.Location) time.Time {
+	return startOfDayOffset(now(), loc, -1)
+}
+
+// GetNextDateTimeIn วันที่ถัดไปอีก day วัน เวลา 00:00:00 ตามโซนเวลาที่กำหนด
+func GetNextDateTimeIn(loc *time.Location, day int) time.Time {
+	return startOfDayOffset(now(), loc, day)
+}
+
+// GetPreviousDateTimeIn วันที่ย้อนหลัง day วัน เวลา 00:00:00 ตามโซนเวลาที่กำหนด
+func GetPreviousDateTimeIn(loc *time.Location, day int) time.Time {
+	return startOfDayOffset(now(), loc, -day)
+}
+
+// คืนเวลา 00:00:00 ของวันที่ t (ตามโซนเวลา loc) บวกเพิ่ม days วัน
+func startOfDayOffset(t time.Time, loc *time.Location, days int) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day()+days, 0, 0, 0, 0, loc)
+}
+
+// Zone ตั้งค่าโซนเวลาและนาฬิกาแยกเป็นรายตัว สำหรับระบบที่มีหลายสาขาหลายโซนเวลา
+type Zone struct {
+	Location *time.Location
+	Clock    Clock // ถ้าเป็น nil จะใช้นาฬิกาของแพ็กเกจ
+}
+
+// NewZone สร้าง Zone จากชื่อโซนเวลา เช่น "Asia/Yangon"
+func NewZone(name string) (*Zone, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("load location %q: %w", name, err)
+	}
+	return &Zone{Location: loc}, nil
+}
+
+// Now เวลาปัจจุบันตามโซนเวลาของ Zone
+func (z *Zone) Now() time.Time {
+	c := z.Clock
+	if c == nil {
+		c = CurrentClock()
+	}
+	return c.Now().In(z.Location)
+}
+
+// Today วันที่ปัจจุบัน เวลา 00:00:00
+func (z *Zone) Today() time.Time {
+	return startOfDayOffset(z.Now(), z.Location, 0)
+}
+
+// Yesterday วันที่เมื่อวาน เวลา 00:00:00
+func (z *Zone) Yesterday() time.Time {
+	return startOfDayOffset(z.Now(), z.Location, -1)
+}
+
+// NextDateTime วันที่ถัดไปอีก day วัน เวลา 00:00:00
+func (z *Zone) NextDateTime(day int) time.Time {
+	return startOfDayOffset(z.Now(), z.Location, day)
+}
+
+// PreviousDateTime วันที่ย้อนหลัง day วัน เวลา 00:00:00
+func (z *Zone) PreviousDateTime(day int) time.Time {
+	return startOfDayOffset(z.Now(), z.Location, -day)
+}
@@ -0,0 +1,46 @@
+package aider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoadLocationOrFixed(t *testing.T) {
+	loc, err := LoadLocationOrFixed("Invalid/Zone", defaultLocationOffset)
+	if err == nil {
+		t.Fatalf("LoadLocationOrFixed() expected error")
+	}
+	if loc == nil {
+		t.Fatalf("LoadLocationOrFixed() returned nil location")
+	}
+	name, offset := time.Date(2025, 1, 1, 0, 0, 0, 0, loc).Zone()
+	if name != "+07" || offset != defaultLocationOffset {
+		t.Errorf("LoadLocationOrFixed() zone = %v %v, want +07 %v", name, offset, defaultLocationOffset)
+	}
+}
+
+func TestSetLocation(t *testing.T) {
+	SetClock(NewFakeClock(time.Date(2025, 3, 1, 20, 0, 0, 0, time.UTC)))
+	defer SetClock(nil)
+	defer SetLocation(nil)
+
+	// 20:00 UTC เป็นวันที่ 2 มี.ค. ตามเวลาไทย แต่ยังเป็นวันที่ 1 มี.ค. ตามเวลา UTC
+	if got := GetToday().Day(); got != 2 {
+		t.Errorf("GetToday() day = %v, want 2", got)
+	}
+	SetLocation(time.UTC)
+	if got := GetToday().Day(); got != 1 {
+		t.Errorf("GetToday() in UTC day = %v, want 1", got)
+	}
+	if err := SetLocationName("Invalid/Zone"); err == nil {
+		t.Errorf("SetLocationName() expected error")
+	}
+	if Location() != time.UTC {
+		t.Errorf("SetLocationName() error should keep the previous location")
+	}
+
+	zone := &Zone{Location: time.FixedZone("+07", defaultLocationOffset)}
+	if got := zone.Yesterday().Day(); got != 1 {
+		t.Errorf("Zone.Yesterday() day = %v, want 1", got)
+	}
+}
@@ -0,0 +1,76 @@
+package aider
+
+import (
+	"errors"
+	"time"
+)
+
+// CalendarCell ช่องวันที่ 1 ช่องในตารางปฏิทินรายเดือน
+type CalendarCell struct {
+	Date    CivilDate
+	InMonth bool // เป็นวันที่ของเดือนที่แสดง (false = วันของเดือนก่อนหน้าหรือถัดไปที่เติมให้ครบสัปดาห์)
+	Weekday time.Weekday
+	Holiday string // ชื่อวันหยุด (ว่างถ้าไม่ใช่วันหยุดหรือไม่ได้ระบุปฏิทินวันหยุด)
+}
+
+// MonthGrid ตารางปฏิทินรายเดือน แบ่งเป็นสัปดาห์ละ 7 ช่อง
+type MonthGrid struct {
+	Year    int // ปี ค.ศ.
+	Month   time.Month
+	Title   string   // หัวตาราง เช่น "กุมภาพันธ์ 2568" หรือ "February 2025"
+	Headers []string // ชื่อวันย่อเรียงตามวันแรกของสัปดาห์ เช่น "จ.", "อ.", ... หรือ "Mon", "Tue", ...
+	Weeks   [][]CalendarCell
+}
+
+// NewMonthGrid สร้างตารางปฏิทินของเดือน month ปี ค.ศ. year โดยสัปดาห์เริ่มที่วัน firstDay
+// จำนวนสัปดาห์เท่ากับที่เดือนนั้นครอบคลุม (4-6 สัปดาห์) และเติมวันของเดือนข้างเคียงให้ครบทุกสัปดาห์
+// ถ้าระบุ cal จะใส่ชื่อวันหยุดให้ในแต่ละช่อง
+func NewMonthGrid(year int, month time.Month, firstDay time.Weekday, language string, cal *HolidayCalendar) (MonthGrid, error) {
+	locale, err := lookupLocale(language)
+	if err != nil {
+		return MonthGrid{}, err
+	}
+	if month < time.January || month > time.December {
+		return MonthGrid{}, errors.New("invalid month")
+	}
+
+	loc := loadLocation()
+	first := NewCivilDate(year, month, 1)
+	title, err := FormatThai(first.In(loc), "MMMM EEEE", language)
+	if err != nil {
+		return MonthGrid{}, err
+	}
+
+	grid := MonthGrid{Year: year, Month: month, Title: title}
+	for i := 0; i < 7; i++ {
+		wd := (int(firstDay) + i) % 7
+		grid.Headers = append(grid.Headers, locale.WeekdaysShort[wd])
+	}
+
+	d := first.AddDays(-((int(first.Weekday()) - int(firstDay) + 7) % 7))
+	last := NewCivilDate(year, month, daysInMonth(year, month))
+	for !d.After(last) {
+		week := make([]CalendarCell, 7)
+		for i := range week {
+			cell := CalendarCell{Date: d, InMonth: d.Month == month, Weekday: d.Weekday()}
+			if cal != nil {
+				if h, ok := cal.Holiday(d.In(loc)); ok {
+					cell.Holiday = h.Name
+				}
+			}
+			week[i] = cell
+			d = d.AddDays(1)
+		}
+		grid.Weeks = append(grid.Weeks, week)
+	}
+	return grid, nil
+
+	/*
+		Ex.
+		grid, _ := NewMonthGrid(2025, time.April, time.Monday, "th", NewThaiHolidayCalendar())
+		grid.Title      // เมษายน 2568
+		grid.Headers    // [จ. อ. พ. พฤ. ศ. ส. อา.]
+		grid.Weeks[0][0] // {Date: 2025-03-31, InMonth: false, Weekday: Monday}
+		grid.Weeks[1][6] // {Date: 2025-04-13, InMonth: true, Weekday: Sunday, Holiday: วันสงกรานต์}
+	*/
+}
@@ -0,0 +1,48 @@
+package aider
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewMonthGrid(t *testing.T) {
+	grid, err := NewMonthGrid(2025, time.April, time.Monday, "th", NewThaiHolidayCalendar())
+	if err != nil {
+		t.Fatalf("NewMonthGrid() error = %v", err)
+	}
+	if grid.Title != "เมษายน 2568" {
+		t.Errorf("Title = %v", grid.Title)
+	}
+	if got := strings.Join(grid.Headers, " "); got != "จ. อ. พ. พฤ. ศ. ส. อา." {
+		t.Errorf("Headers = %v", got)
+	}
+	if len(grid.Weeks) != 5 {
+		t.Fatalf("len(Weeks) = %v, want 5", len(grid.Weeks))
+	}
+	if first := grid.Weeks[0][0]; first.Date != (CivilDate{2025, time.March, 31}) || first.InMonth || first.Weekday != time.Monday {
+		t.Errorf("Weeks[0][0] = %+v", first)
+	}
+	if songkran := grid.Weeks[1][6]; songkran.Date != (CivilDate{2025, time.April, 13}) || songkran.Holiday != "วันสงกรานต์" {
+		t.Errorf("Weeks[1][6] = %+v", songkran)
+	}
+	if last := grid.Weeks[4][6]; last.Date != (CivilDate{2025, time.May, 4}) || last.InMonth || last.Holiday != "วันฉัตรมงคล" {
+		t.Errorf("Weeks[4][6] = %+v", last)
+	}
+
+	// กุมภาพันธ์ 2026 เริ่มวันอาทิตย์และมี 28 วัน พอดี 4 สัปดาห์
+	grid, err = NewMonthGrid(2026, time.February, time.Sunday, "en", nil)
+	if err != nil {
+		t.Fatalf("NewMonthGrid() error = %v", err)
+	}
+	if grid.Title != "February 2026" || grid.Headers[0] != "Sun" || len(grid.Weeks) != 4 {
+		t.Errorf("NewMonthGrid() = %v %v %d weeks", grid.Title, grid.Headers, len(grid.Weeks))
+	}
+	if grid.Weeks[3][6].Date != (CivilDate{2026, time.February, 28}) || grid.Weeks[3][6].Holiday != "" {
+		t.Errorf("Weeks[3][6] = %+v", grid.Weeks[3][6])
+	}
+
+	if _, err := NewMonthGrid(2025, 13, time.Monday, "th", nil); err == nil {
+		t.Errorf("NewMonthGrid() expected error for invalid month")
+	}
+}
@@ -0,0 +1,111 @@
+package aider
+
+import "time"
+
+// ฟังก์ชันในไฟล์นี้แปลงเวลาเป็นโซนเวลาของแพ็กเกจ (ค่าเริ่มต้น Asia/Bangkok) ก่อนคำนวณเสมอ
+// เวลาสิ้นสุดของช่วง (EndOf...) คือ 1 นาโนวินาทีก่อนเริ่มช่วงถัดไป
+
+// StartOfDay เวลา 00:00:00 ของวันที่ t
+func StartOfDay(t time.Time) time.Time {
+	return startOfDayOffset(t, loadLocation(), 0)
+}
+
+// EndOfDay เวลา 23:59:59.999999999 ของวันที่ t
+func EndOfDay(t time.Time) time.Time {
+	return startOfDayOffset(t, loadLocation(), 1).Add(-time.Nanosecond)
+}
+
+// StartOfWeek วันแรกของสัปดาห์ที่ t อยู่ โดยสัปดาห์เริ่มที่วัน firstDay (เช่น time.Monday หรือ time.Sunday)
+func StartOfWeek(t time.Time, firstDay time.Weekday) time.Time {
+	t = t.In(loadLocation())
+	days := (int(t.Weekday()) - int(firstDay) + 7) % 7
+	return startOfDayOffset(t, t.Location(), -days)
+}
+
+// EndOfWeek เวลาสุดท้ายของสัปดาห์ที่ t อยู่ โดยสัปดาห์เริ่มที่วัน firstDay
+func EndOfWeek(t time.Time, firstDay time.Weekday) time.Time {
+	return StartOfWeek(t, firstDay).AddDate(0, 0, 7).Add(-time.Nanosecond)
+}
+
+// StartOfMonth วันที่ 1 ของเดือนที่ t อยู่
+func StartOfMonth(t time.Time) time.Time {
+	t = t.In(loadLocation())
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfMonth เวลาสุดท้ายของวันสุดท้ายในเดือนที่ t อยู่
+func EndOfMonth(t time.Time) time.Time {
+	return StartOfMonth(t).AddDate(0, 1, 0).Add(-time.Nanosecond)
+}
+
+// Quarter ไตรมาสตามปีปฏิทิน (1-4) ของ t
+func Quarter(t time.Time) int {
+	return (int(t.In(loadLocation()).Month())-1)/3 + 1
+}
+
+// StartOfQuarter วันแรกของไตรมาส (ตามปีปฏิทิน) ที่ t อยู่
+func StartOfQuarter(t time.Time) time.Time {
+	t = t.In(loadLocation())
+	month := time.Month((Quarter(t)-1)*3 + 1)
+	return time.Date(t.Year(), month, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfQuarter เวลาสุดท้ายของไตรมาส (ตามปีปฏิทิน) ที่ t อยู่
+func EndOfQuarter(t time.Time) time.Time {
+	return StartOfQuarter(t).AddDate(0, 3, 0).Add(-time.Nanosecond)
+}
+
+// StartOfYear วันที่ 1 มกราคมของปีที่ t อยู่
+func StartOfYear(t time.Time) time.Time {
+	t = t.In(loadLocation())
+	return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfYear เวลาสุดท้ายของวันที่ 31 ธันวาคมของปีที่ t อยู่
+func EndOfYear(t time.Time) time.Time {
+	return StartOfYear(t).AddDate(1, 0, 0).Add(-time.Nanosecond)
+}
+
+// ปีงบประมาณของไทยเริ่ม 1 ตุลาคม ถึง 30 กันยายน และเรียกตามปี พ.ศ. ที่สิ้นสุด
+// เช่น 1 ต.ค. 2567 - 30 ก.ย. 2568 คือปีงบประมาณ 2568
+const fiscalYearStartMonth = time.October
+
+// FiscalYear ปีงบประมาณ (พ.ศ.) ของ t
+func FiscalYear(t time.Time) int {
+	t = t.In(loadLocation())
+	year := t.Year()
+	if t.Month() >= fiscalYearStartMonth {
+		year++
+	}
+	return year + buddhistEraOffset
+}
+
+// FiscalPeriod งวด (เดือนที่) ในปีงบประมาณของ t (ตุลาคม = 1 ... กันยายน = 12)
+func FiscalPeriod(t time.Time) int {
+	month := int(t.In(loadLocation()).Month())
+	return (month-int(fiscalYearStartMonth)+12)%12 + 1
+}
+
+// FiscalQuarter ไตรมาสในปีงบประมาณของ t (ต.ค.-ธ.ค. = 1, ม.ค.-มี.ค. = 2, เม.ย.-มิ.ย. = 3, ก.ค.-ก.ย. = 4)
+func FiscalQuarter(t time.Time) int {
+	return (FiscalPeriod(t)-1)/3 + 1
+}
+
+// FiscalYearRange ช่วงเวลาของปีงบประมาณ (พ.ศ.) แบบครึ่งเปิด [1 ต.ค. ปีก่อน, 1 ต.ค. ของปีนั้น)
+func FiscalYearRange(fiscalYear int) TimeRange {
+	start := time.Date(fiscalYear-buddhistEraOffset-1, fiscalYearStartMonth, 1, 0, 0, 0, 0, loadLocation())
+	return NewTimeRange(start, start.AddDate(1, 0, 0))
+
+	/*
+		Ex.
+		r := FiscalYearRange(2568)
+		r.Start // 2024-10-01 00:00:00 +0700
+		r.End   // 2025-10-01 00:00:00 +0700 (ไม่รวม)
+	*/
+}
+
+// FiscalQuarterRange ช่วงเวลาของไตรมาส (1-4) ในปีงบประมาณ (พ.ศ.) แบบครึ่งเปิด
+func FiscalQuarterRange(fiscalYear, quarter int) TimeRange {
+	start := FiscalYearRange(fiscalYear).Start.AddDate(0, (quarter-1)*3, 0)
+	return NewTimeRange(start, start.AddDate(0, 3, 0))
+}
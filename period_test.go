@@ -0,0 +1,72 @@
+package aider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeriodBoundaries(t *testing.T) {
+	loc := loadLocation()
+	// 2025-02-19 20:00 UTC คือวันพฤหัสบดีที่ 20 ก.พ. 2568 03:00 ตามเวลาไทย
+	ts := time.Date(2025, 2, 19, 20, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		got  time.Time
+		want time.Time
+	}{
+		{name: "StartOfDay", got: StartOfDay(ts), want: time.Date(2025, 2, 20, 0, 0, 0, 0, loc)},
+		{name: "EndOfDay", got: EndOfDay(ts), want: time.Date(2025, 2, 20, 23, 59, 59, 999999999, loc)},
+		{name: "StartOfWeek จันทร์", got: StartOfWeek(ts, time.Monday), want: time.Date(2025, 2, 17, 0, 0, 0, 0, loc)},
+		{name: "StartOfWeek อาทิตย์", got: StartOfWeek(ts, time.Sunday), want: time.Date(2025, 2, 16, 0, 0, 0, 0, loc)},
+		{name: "EndOfWeek", got: EndOfWeek(ts, time.Monday), want: time.Date(2025, 2, 23, 23, 59, 59, 999999999, loc)},
+		{name: "EndOfMonth", got: EndOfMonth(ts), want: time.Date(2025, 2, 28, 23, 59, 59, 999999999, loc)},
+		{name: "StartOfQuarter", got: StartOfQuarter(ts), want: time.Date(2025, 1, 1, 0, 0, 0, 0, loc)},
+		{name: "EndOfQuarter", got: EndOfQuarter(ts), want: time.Date(2025, 3, 31, 23, 59, 59, 999999999, loc)},
+		{name: "EndOfYear", got: EndOfYear(ts), want: time.Date(2025, 12, 31, 23, 59, 59, 999999999, loc)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFiscalYear(t *testing.T) {
+	loc := loadLocation()
+	tests := []struct {
+		name        string
+		date        time.Time
+		wantYear    int
+		wantQuarter int
+		wantPeriod  int
+	}{
+		{name: "ต้นปีงบประมาณ", date: time.Date(2024, 10, 1, 0, 0, 0, 0, loc), wantYear: 2568, wantQuarter: 1, wantPeriod: 1},
+		{name: "กลางปีงบประมาณ", date: time.Date(2025, 2, 21, 0, 0, 0, 0, loc), wantYear: 2568, wantQuarter: 2, wantPeriod: 5},
+		{name: "สิ้นปีงบประมาณ", date: time.Date(2025, 9, 30, 23, 0, 0, 0, loc), wantYear: 2568, wantQuarter: 4, wantPeriod: 12},
+		{name: "UTC ข้ามปีงบประมาณตามเวลาไทย", date: time.Date(2025, 9, 30, 18, 0, 0, 0, time.UTC), wantYear: 2569, wantQuarter: 1, wantPeriod: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FiscalYear(tt.date); got != tt.wantYear {
+				t.Errorf("FiscalYear() = %v, want %v", got, tt.wantYear)
+			}
+			if got := FiscalQuarter(tt.date); got != tt.wantQuarter {
+				t.Errorf("FiscalQuarter() = %v, want %v", got, tt.wantQuarter)
+			}
+			if got := FiscalPeriod(tt.date); got != tt.wantPeriod {
+				t.Errorf("FiscalPeriod() = %v, want %v", got, tt.wantPeriod)
+			}
+		})
+	}
+
+	r := FiscalYearRange(2568)
+	if !r.Start.Equal(time.Date(2024, 10, 1, 0, 0, 0, 0, loc)) || !r.End.Equal(time.Date(2025, 10, 1, 0, 0, 0, 0, loc)) {
+		t.Errorf("FiscalYearRange() = %v", r)
+	}
+	q := FiscalQuarterRange(2568, 2)
+	if !q.Start.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, loc)) || !q.End.Equal(time.Date(2025, 4, 1, 0, 0, 0, 0, loc)) {
+		t.Errorf("FiscalQuarterRange() = %v", q)
+	}
+}
@@ -0,0 +1,197 @@
+package aider
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"runtime/debug"
+)
+
+// ProblemContentType content type of RFC 7807 problem details responses.
+const ProblemContentType = "application/problem+json"
+
+// ProblemDetails is an RFC 7807 problem details object.
+// Extensions are rendered as additional top-level members and cannot override the standard ones.
+type ProblemDetails struct {
+	Type       string         `json:"type,omitempty"`
+	Title      string         `json:"title,omitempty"`
+	Status     int            `json:"status,omitempty"`
+	Detail     string         `json:"detail,omitempty"`
+	Instance   string         `json:"instance,omitempty"`
+	Extensions map[string]any `json:"-"`
+}
+
+// MarshalJSON renders the standard members together with the extension members.
+func (p ProblemDetails) MarshalJSON() ([]byte, error) {
+	m := make(map[string]any, len(p.Extensions)+5)
+	for k, v := range p.Extensions {
+		m[k] = v
+	}
+	set := func(key, value string) {
+		if value != "" {
+			m[key] = value
+		} else {
+			delete(m, key)
+		}
+	}
+	set("type", p.Type)
+	set("title", p.Title)
+	set("detail", p.Detail)
+	set("instance", p.Instance)
+	delete(m, "status")
+	if p.Status != 0 {
+		m["status"] = p.Status
+	}
+	return json.Marshal(m)
+}
+
+// HTTPStatus maps err to an HTTP error status using ErrorCode.HTTPStatus.
+// Codes that do not map to a 4xx or 5xx status (such as 0, CodeOK or 302) and errors
+// that are not a CustomError become 500. A nil error maps to 200.
+func HTTPStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	if status := CodeOf(err).HTTPStatus(); status >= 400 && status <= 599 {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// NewProblemDetails builds the problem details for err.
+// Only the CustomError Message and Details are exposed; the wrapped cause is never rendered,
+// and errors that are not a CustomError get no detail at all.
+func NewProblemDetails(err error, r *http.Request) ProblemDetails {
+	status := HTTPStatus(err)
+	p := ProblemDetails{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+	}
+	if r != nil && r.URL != nil {
+		p.Instance = r.URL.Path
+	}
+	var e *CustomError
+	if errors.As(err, &e) {
+		p.Detail = e.Message
+		p.Extensions = e.Details
+	}
+	return p
+}
+
+// WriteProblem writes err as an application/problem+json response.
+func WriteProblem(w http.ResponseWriter, r *http.Request, err error) {
+	p := NewProblemDetails(err, r)
+	body, marshalErr := json.Marshal(p)
+	if marshalErr != nil {
+		// details that cannot be encoded are dropped rather than failing the response
+		p.Extensions = nil
+		body, _ = json.Marshal(p)
+	}
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	_, _ = w.Write(body)
+
+	/*
+		Ex.
+		WriteProblem(w, r, NewError(ErrNotFound, "user not found"))
+		// HTTP/1.1 404 Not Found
+		// Content-Type: application/problem+json
+		// {"detail":"user not found","instance":"/users/42","status":404,"title":"Not Found","type":"about:blank"}
+	*/
+}
+
+// ErrorHandler is an http.Handler that returns an error instead of writing it.
+// A non-nil error is rendered with WriteProblem. If the handler already started the
+// response (e.g. a failed json.Encoder.Encode), the status can no longer be changed,
+// so the error is only logged.
+type ErrorHandler func(w http.ResponseWriter, r *http.Request) error
+
+// ServeHTTP implements http.Handler.
+func (h ErrorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tw := &trackingResponseWriter{ResponseWriter: w}
+	err := h(tw, r)
+	switch {
+	case err == nil:
+	case tw.written:
+		log.Printf("error serving %s %s after response started: %v", r.Method, r.URL.Path, err)
+	default:
+		WriteProblem(w, r, err)
+	}
+
+	/*
+		Ex.
+		mux.Handle("GET /users/{id}", ErrorHandler(func(w http.ResponseWriter, r *http.Request) error {
+			user, err := findUser(r.PathValue("id"))
+			if err != nil {
+				return Wrap(err, ErrNotFound, "user not found")
+			}
+			return json.NewEncoder(w).Encode(user)
+		}))
+	*/
+}
+
+// Recoverer recovers panics from next, logs the panic value and stack,
+// and responds with a generic ErrInternal problem that does not expose the panic.
+// If next already started the response, the panic is only logged and the connection
+// is aborted with http.ErrAbortHandler, since the status can no longer be changed.
+// http.ErrAbortHandler is re-panicked so net/http can abort the connection as usual.
+func Recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tw := &trackingResponseWriter{ResponseWriter: w}
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+			if rec == http.ErrAbortHandler {
+				panic(rec)
+			}
+			log.Printf("panic serving %s %s: %v\n%s", r.Method, r.URL.Path, rec, debug.Stack())
+			if tw.written {
+				panic(http.ErrAbortHandler)
+			}
+			WriteProblem(w, r, NewError(ErrInternal, "internal server error"))
+		}()
+		next.ServeHTTP(tw, r)
+	})
+
+	/*
+		Ex.
+		http.ListenAndServe(":8080", Recoverer(mux))
+	*/
+}
+
+// trackingResponseWriter records whether the response has been started.
+type trackingResponseWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (w *trackingResponseWriter) WriteHeader(code int) {
+	// 1xx informational responses do not start the final response
+	if code >= 200 {
+		w.written = true
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *trackingResponseWriter) Write(b []byte) (int, error) {
+	w.written = true
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher when the underlying writer supports it.
+func (w *trackingResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		w.written = true
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *trackingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,167 @@
+package aider
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "nil", err: nil, want: http.StatusOK},
+		{name: "not found", err: NewError(ErrNotFound, "user not found"), want: http.StatusNotFound},
+		{name: "canonical code", err: NewError(CodeResourceExhausted, "too many requests"), want: http.StatusTooManyRequests},
+		{name: "รหัสที่ไม่ใช่ HTTP", err: NewError(9999, "custom"), want: http.StatusInternalServerError},
+		{name: "error ทั่วไป", err: errors.New("boom"), want: http.StatusInternalServerError},
+		{name: "CustomError ว่าง", err: &CustomError{}, want: http.StatusInternalServerError},
+		{name: "CodeOK", err: NewError(CodeOK, "x"), want: http.StatusInternalServerError},
+		{name: "สถานะ 200", err: NewError(200, "x"), want: http.StatusInternalServerError},
+		{name: "สถานะ 302", err: NewError(302, "x"), want: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HTTPStatus(tt.err); got != tt.want {
+				t.Errorf("HTTPStatus() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProblemDetailsMarshalJSON(t *testing.T) {
+	p := ProblemDetails{
+		Type:       "about:blank",
+		Title:      "Bad Request",
+		Status:     400,
+		Detail:     "invalid amount",
+		Extensions: map[string]any{"field": "amount", "status": 999, "title": "override"},
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"detail":"invalid amount","field":"amount","status":400,"title":"Bad Request","type":"about:blank"}`
+	if string(b) != want {
+		t.Errorf("Marshal() = %s, want %s", b, want)
+	}
+}
+
+func TestWriteProblem(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "CustomError พร้อม details",
+			err:        WithDetails(Wrap(errors.New("sql: no rows"), ErrNotFound, "user not found"), map[string]any{"id": "42"}),
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"detail":"user not found","id":"42","instance":"/users/42","status":404,"title":"Not Found","type":"about:blank"}`,
+		},
+		{
+			name:       "error ทั่วไปไม่แสดงรายละเอียด",
+			err:        errors.New("dial tcp 10.0.0.1:5432: connection refused"),
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"instance":"/users/42","status":500,"title":"Internal Server Error","type":"about:blank"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			WriteProblem(rec, httptest.NewRequest(http.MethodGet, "/users/42", nil), tt.err)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != ProblemContentType {
+				t.Errorf("Content-Type = %q, want %q", ct, ProblemContentType)
+			}
+			if got := rec.Body.String(); got != tt.wantBody {
+				t.Errorf("body = %s, want %s", got, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestErrorHandler(t *testing.T) {
+	h := ErrorHandler(func(w http.ResponseWriter, r *http.Request) error {
+		if r.URL.Query().Get("fail") != "" {
+			return NewError(ErrBadRequest, "missing name")
+		}
+		_, err := io.WriteString(w, "ok")
+		return err
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusOK || rec.Body.String() != "ok" {
+		t.Errorf("success response = %d %q, want 200 \"ok\"", rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?fail=1", nil))
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), `"detail":"missing name"`) {
+		t.Errorf("error response = %d %s, want 400 problem", rec.Code, rec.Body.String())
+	}
+
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+	rec = httptest.NewRecorder()
+	ErrorHandler(func(w http.ResponseWriter, r *http.Request) error {
+		io.WriteString(w, `{"partial":`)
+		return NewError(ErrInternal, "encode failed")
+	}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusOK || rec.Body.String() != `{"partial":` {
+		t.Errorf("error after partial write = %d %s, want the partial body only", rec.Code, rec.Body.String())
+	}
+}
+
+func TestRecoverer(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	h := Recoverer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("secret database password leaked")
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/panic", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500", rec.Code)
+	}
+	if body := rec.Body.String(); strings.Contains(body, "secret") || !strings.Contains(body, `"detail":"internal server error"`) {
+		t.Errorf("body = %s, want generic internal error", body)
+	}
+
+	for name, handler := range map[string]http.HandlerFunc{
+		"ErrAbortHandler ส่งต่อ": func(w http.ResponseWriter, r *http.Request) {
+			panic(http.ErrAbortHandler)
+		},
+		"panic หลังเริ่มส่ง response": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("partial"))
+			panic("boom")
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			defer func() {
+				if v := recover(); v != http.ErrAbortHandler {
+					t.Errorf("recover() = %v, want http.ErrAbortHandler", v)
+				}
+				if body := rec.Body.String(); strings.Contains(body, "internal server error") {
+					t.Errorf("body = %s, want no problem appended", body)
+				}
+			}()
+			Recoverer(handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		})
+	}
+}
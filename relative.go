@@ -0,0 +1,185 @@
+package aider
+
+import (
+	"fmt"
+	"time"
+)
+
+// RelativeThresholds เกณฑ์การเลือกหน่วยของข้อความเวลาแบบสัมพัทธ์ ค่าที่เป็น 0 จะใช้ค่าจาก DefaultRelativeThresholds
+type RelativeThresholds struct {
+	JustNow time.Duration // ต่างกันน้อยกว่านี้แสดง "เมื่อสักครู่" / "just now"
+	Minutes time.Duration // ต่างกันน้อยกว่านี้แสดงเป็นนาที
+	Hours   time.Duration // ต่างกันน้อยกว่านี้แสดงเป็นชั่วโมง
+	Days    int           // ต่างกันน้อยกว่านี้ (วัน) แสดงเป็นวัน
+	Weeks   int           // ต่างกันน้อยกว่านี้ (วัน) แสดงเป็นสัปดาห์
+	Months  int           // ต่างกันน้อยกว่านี้ (วัน) แสดงเป็นเดือน มากกว่านั้นแสดงเป็นปี
+
+	// ปิดการแสดง "เมื่อวานนี้ 14:30" / "พรุ่งนี้ 14:30" แล้วแสดงเป็นจำนวนวันแทน
+	DisableCalendarDays bool
+}
+
+// DefaultRelativeThresholds เกณฑ์เริ่มต้นของ RelativeTime
+var DefaultRelativeThresholds = RelativeThresholds{
+	JustNow: 45 * time.Second,
+	Minutes: time.Hour,
+	Hours:   24 * time.Hour,
+	Days:    7,
+	Weeks:   30,
+	Months:  365,
+}
+
+// ข้อความของแต่ละภาษา
+type relativeWords struct {
+	justNow, soon       string
+	past, future        string // รูปแบบ fmt สำหรับ "%d หน่วย"
+	yesterday, tomorrow string // รูปแบบ fmt สำหรับเวลา "HH:mm"
+	units               map[string][2]string
+}
+
+var relativeLanguages = map[string]relativeWords{
+	"th": {
+		justNow:   "เมื่อสักครู่",
+		soon:      "อีกสักครู่",
+		past:      "%sที่แล้ว",
+		future:    "อีก %s",
+		yesterday: "เมื่อวานนี้ %s",
+		tomorrow:  "พรุ่งนี้ %s",
+		units: map[string][2]string{
+			"minute": {"นาที", "นาที"},
+			"hour":   {"ชั่วโมง", "ชั่วโมง"},
+			"day":    {"วัน", "วัน"},
+			"week":   {"สัปดาห์", "สัปดาห์"},
+			"month":  {"เดือน", "เดือน"},
+			"year":   {"ปี", "ปี"},
+		},
+	},
+	"en": {
+		justNow:   "just now",
+		soon:      "in a moment",
+		past:      "%s ago",
+		future:    "in %s",
+		yesterday: "yesterday at %s",
+		tomorrow:  "tomorrow at %s",
+		units: map[string][2]string{
+			"minute": {"minute", "minutes"},
+			"hour":   {"hour", "hours"},
+			"day":    {"day", "days"},
+			"week":   {"week", "weeks"},
+			"month":  {"month", "months"},
+			"year":   {"year", "years"},
+		},
+	},
+}
+
+// RelativeTime แสดงเวลา t เทียบกับเวลาอ้างอิง ref เป็นข้อความ เช่น "3 นาทีที่แล้ว", "เมื่อวานนี้ 14:30", "อีก 2 วัน"
+// หรือ "5 minutes ago" ตามภาษา (th/en) โดยใช้เกณฑ์ DefaultRelativeThresholds
+func RelativeTime(t, ref time.Time, language string) (string, error) {
+	return RelativeTimeWith(t, ref, language, DefaultRelativeThresholds)
+
+	/*
+		Ex.
+		ref := time.Date(2025, 2, 21, 10, 0, 0, 0, loadLocation())
+		RelativeTime(ref.Add(-3*time.Minute), ref, "th")  // 3 นาทีที่แล้ว
+		RelativeTime(ref.Add(-30*time.Hour), ref, "th")   // เมื่อวานนี้ 04:00
+		RelativeTime(ref.AddDate(0, 0, 2), ref, "th")     // อีก 2 วัน
+		RelativeTime(ref.Add(-5*time.Minute), ref, "en")  // 5 minutes ago
+	*/
+}
+
+// RelativeTimeNow เหมือน RelativeTime โดยใช้เวลาปัจจุบันจากนาฬิกาของแพ็กเกจเป็นเวลาอ้างอิง
+func RelativeTimeNow(t time.Time, language string) (string, error) {
+	return RelativeTime(t, now(), language)
+}
+
+// RelativeTimeWith เหมือน RelativeTime แต่กำหนดเกณฑ์การเลือกหน่วยเองได้
+func RelativeTimeWith(t, ref time.Time, language string, th RelativeThresholds) (string, error) {
+	words, ok := relativeLanguages[language]
+	if !ok {
+		return "", ErrInvalidLanguage
+	}
+	th = th.withDefaults()
+
+	loc := loadLocation()
+	t, ref = t.In(loc), ref.In(loc)
+	diff := t.Sub(ref)
+	future := diff > 0
+	if diff < 0 {
+		diff = -diff
+	}
+
+	if diff < th.JustNow {
+		if future {
+			return words.soon, nil
+		}
+		return words.justNow, nil
+	}
+
+	var unit string
+	var n int
+	switch {
+	case diff < th.Minutes:
+		unit, n = "minute", int(diff/time.Minute)
+	case diff < th.Hours:
+		unit, n = "hour", int(diff/time.Hour)
+	default:
+		days := CivilDateOf(t).DaysSince(CivilDateOf(ref))
+		if days < 0 {
+			days = -days
+		}
+		switch {
+		case days == 0:
+			// ยังเป็นวันเดียวกัน (เกณฑ์ Hours น้อยกว่า 24 ชั่วโมง) แสดงเป็นชั่วโมงต่อ
+			unit, n = "hour", int(diff/time.Hour)
+		case days == 1 && !th.DisableCalendarDays:
+			clock := t.Format("15:04")
+			if future {
+				return fmt.Sprintf(words.tomorrow, clock), nil
+			}
+			return fmt.Sprintf(words.yesterday, clock), nil
+		case days < th.Days:
+			unit, n = "day", days
+		case days < th.Weeks:
+			unit, n = "week", days/7
+		case days < th.Months:
+			unit, n = "month", days/30
+		default:
+			unit, n = "year", days/365
+		}
+	}
+	if n < 1 {
+		n = 1
+	}
+
+	label := words.units[unit][0]
+	if n > 1 {
+		label = words.units[unit][1]
+	}
+	amount := fmt.Sprintf("%d %s", n, label)
+	if future {
+		return fmt.Sprintf(words.future, amount), nil
+	}
+	return fmt.Sprintf(words.past, amount), nil
+}
+
+func (th RelativeThresholds) withDefaults() RelativeThresholds {
+	d := DefaultRelativeThresholds
+	if th.JustNow == 0 {
+		th.JustNow = d.JustNow
+	}
+	if th.Minutes == 0 {
+		th.Minutes = d.Minutes
+	}
+	if th.Hours == 0 {
+		th.Hours = d.Hours
+	}
+	if th.Days == 0 {
+		th.Days = d.Days
+	}
+	if th.Weeks == 0 {
+		th.Weeks = d.Weeks
+	}
+	if th.Months == 0 {
+		th.Months = d.Months
+	}
+	return th
+}
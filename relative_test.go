@@ -0,0 +1,67 @@
+package aider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeTime(t *testing.T) {
+	ref := time.Date(2025, 2, 21, 20, 0, 0, 0, loadLocation())
+	tests := []struct {
+		name     string
+		t        time.Time
+		language string
+		want     string
+	}{
+		{name: "เมื่อสักครู่", t: ref.Add(-10 * time.Second), language: "th", want: "เมื่อสักครู่"},
+		{name: "นาทีที่แล้ว", t: ref.Add(-3 * time.Minute), language: "th", want: "3 นาทีที่แล้ว"},
+		{name: "ชั่วโมงข้างหน้า", t: ref.Add(2 * time.Hour), language: "th", want: "อีก 2 ชั่วโมง"},
+		{name: "เมื่อวาน", t: time.Date(2025, 2, 20, 14, 30, 0, 0, loadLocation()), language: "th", want: "เมื่อวานนี้ 14:30"},
+		{name: "หลายวันข้างหน้า", t: ref.AddDate(0, 0, 2), language: "th", want: "อีก 2 วัน"},
+		{name: "สัปดาห์ที่แล้ว", t: ref.AddDate(0, 0, -15), language: "th", want: "2 สัปดาห์ที่แล้ว"},
+		{name: "ปีที่แล้ว", t: ref.AddDate(-2, 0, 0), language: "th", want: "2 ปีที่แล้ว"},
+		{name: "ภาษาอังกฤษ", t: ref.Add(-5 * time.Minute), language: "en", want: "5 minutes ago"},
+		{name: "ภาษาอังกฤษ เอกพจน์", t: ref.Add(time.Hour), language: "en", want: "in 1 hour"},
+		{name: "ภาษาอังกฤษ พรุ่งนี้", t: time.Date(2025, 2, 22, 21, 0, 0, 0, loadLocation()), language: "en", want: "tomorrow at 21:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RelativeTime(tt.t, ref, tt.language)
+			if err != nil {
+				t.Fatalf("RelativeTime() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RelativeTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := RelativeTime(ref, ref, "jp"); err == nil {
+		t.Errorf("RelativeTime() expected error for invalid language")
+	}
+}
+
+func TestRelativeTimeWith(t *testing.T) {
+	ref := time.Date(2025, 2, 21, 20, 0, 0, 0, loadLocation())
+	tests := []struct {
+		name string
+		t    time.Time
+		th   RelativeThresholds
+		want string
+	}{
+		{name: "เกณฑ์นาทีกำหนดเอง", t: ref.Add(-90 * time.Minute), th: RelativeThresholds{Minutes: 2 * time.Hour}, want: "90 minutes ago"},
+		{name: "เกณฑ์ชั่วโมงสั้น วันเดียวกัน", t: ref.Add(-10 * time.Hour), th: RelativeThresholds{Hours: 6 * time.Hour}, want: "10 hours ago"},
+		{name: "เกณฑ์ชั่วโมงสั้น ข้ามวัน", t: ref.Add(-21 * time.Hour), th: RelativeThresholds{Hours: 6 * time.Hour}, want: "yesterday at 23:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RelativeTimeWith(tt.t, ref, "en", tt.th)
+			if err != nil {
+				t.Fatalf("RelativeTimeWith() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RelativeTimeWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
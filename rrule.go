@@ -0,0 +1,413 @@
+package aider
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency ความถี่ของการเกิดซ้ำ (FREQ)
+type Frequency int
+
+const (
+	FreqDaily Frequency = iota + 1
+	FreqWeekly
+	FreqMonthly
+	FreqYearly
+)
+
+var frequencyNames = map[string]Frequency{
+	"DAILY":   FreqDaily,
+	"WEEKLY":  FreqWeekly,
+	"MONTHLY": FreqMonthly,
+	"YEARLY":  FreqYearly,
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// WeekdayNum วันในสัปดาห์ของ BYDAY เช่น MO (ทุกวันจันทร์), 2MO (วันจันทร์ที่สอง), -1FR (วันศุกร์สุดท้าย)
+type WeekdayNum struct {
+	N       int // ลำดับในเดือน (หรือในปีสำหรับ YEARLY ที่ไม่มี BYMONTH) 0 = ทุกวันนั้น ติดลบนับจากท้าย
+	Weekday time.Weekday
+}
+
+// BusinessDayAdjustment วิธีเลื่อนวันที่เกิดซ้ำเมื่อไม่ตรงกับวันทำการ
+type BusinessDayAdjustment int
+
+const (
+	AdjustNone     BusinessDayAdjustment = iota // ไม่เลื่อน
+	AdjustPrevious                              // เลื่อนไปวันทำการก่อนหน้า
+	AdjustNext                                  // เลื่อนไปวันทำการถัดไป
+)
+
+// RRule กฎการเกิดซ้ำตาม RFC 5545 (รองรับ FREQ, INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY, BYDAY, BYSETPOS และ WKST)
+// การคำนวณทั้งหมดทำในโซนเวลาของแพ็กเกจ (ค่าเริ่มต้น Asia/Bangkok) และใช้เวลาของวันจาก Start
+type RRule struct {
+	Start      time.Time // DTSTART วันเวลาเริ่มต้น (นับเป็นครั้งแรกถ้าตรงกับกฎ)
+	Freq       Frequency
+	Interval   int       // ทุก ๆ กี่รอบ (0 ถือเป็น 1)
+	Count      int       // จำนวนครั้งสูงสุด (0 = ไม่จำกัด)
+	Until      time.Time // เวลาสุดท้ายที่เกิดได้ (ค่าว่าง = ไม่จำกัด)
+	ByMonth    []time.Month
+	ByMonthDay []int // วันที่ในเดือน ติดลบนับจากท้ายเดือน (-1 = วันสุดท้าย)
+	ByDay      []WeekdayNum
+	BySetPos   []int        // เลือกลำดับจากวันที่ได้ในแต่ละรอบ (-1 = ตัวสุดท้าย)
+	WeekStart  time.Weekday // WKST วันแรกของสัปดาห์ (ParseRRule ใช้ค่าเริ่มต้น Monday)
+
+	Adjust   BusinessDayAdjustment // เลื่อนวันที่ไม่ใช่วันทำการ (ไม่มีผลต่อการนับ Count)
+	Calendar *HolidayCalendar      // ปฏิทินวันหยุดที่ใช้กับ Adjust (nil = พิจารณาเฉพาะเสาร์-อาทิตย์)
+}
+
+// ParseRRule แปลงข้อความ RRULE เช่น "FREQ=MONTHLY;BYDAY=2MO" (จะมี "RRULE:" นำหน้าหรือไม่ก็ได้) โดยเริ่มที่ start
+// UNTIL ที่เป็นวันที่อย่างเดียวหรือไม่มี Z จะถือเป็นเวลาตามโซนเวลาของแพ็กเกจ
+func ParseRRule(s string, start time.Time) (RRule, error) {
+	input := s
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	r := RRule{Start: start, Interval: 1, WeekStart: time.Monday}
+
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return RRule{}, fmt.Errorf("invalid rrule %q: malformed part %q", input, part)
+		}
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			f, ok := frequencyNames[strings.ToUpper(value)]
+			if !ok {
+				return RRule{}, fmt.Errorf("invalid rrule %q: unsupported frequency %q", input, value)
+			}
+			r.Freq = f
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err == nil && r.Interval < 1 {
+				err = fmt.Errorf("interval must be positive")
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if err == nil && r.Count < 1 {
+				err = fmt.Errorf("count must be positive")
+			}
+		case "UNTIL":
+			r.Until, err = parseRRuleUntil(value)
+		case "BYMONTH":
+			r.ByMonth, err = parseRRuleInts(value, 1, 12, func(n int) time.Month { return time.Month(n) })
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseRRuleInts(value, -31, 31, func(n int) int { return n })
+		case "BYSETPOS":
+			r.BySetPos, err = parseRRuleInts(value, -366, 366, func(n int) int { return n })
+		case "BYDAY":
+			r.ByDay, err = parseRRuleByDay(value)
+		case "WKST":
+			wd, ok := rruleWeekdays[strings.ToUpper(value)]
+			if !ok {
+				err = fmt.Errorf("unknown weekday %q", value)
+			}
+			r.WeekStart = wd
+		default:
+			return RRule{}, fmt.Errorf("invalid rrule %q: unsupported part %q", input, key)
+		}
+		if err != nil {
+			return RRule{}, fmt.Errorf("invalid rrule %q: %s: %w", input, key, err)
+		}
+	}
+	if r.Freq == 0 {
+		return RRule{}, fmt.Errorf("invalid rrule %q: missing FREQ", input)
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return RRule{}, fmt.Errorf("invalid rrule %q: COUNT and UNTIL are mutually exclusive", input)
+	}
+	return r, nil
+
+	/*
+		Ex.
+		start := time.Date(2025, 1, 1, 9, 0, 0, 0, loadLocation())
+		r, _ := ParseRRule("FREQ=MONTHLY;BYMONTHDAY=25", start)
+		r.Adjust, r.Calendar = AdjustPrevious, NewThaiHolidayCalendar()
+		r.Between(start, start.AddDate(0, 6, 0)) // ทุกวันที่ 25 หรือวันทำการก่อนหน้า เวลา 09:00
+
+		ParseRRule("FREQ=MONTHLY;BYDAY=2MO", start)    // วันจันทร์ที่สองของทุกเดือน
+		ParseRRule("FREQ=MONTHLY;BYMONTHDAY=-1", start) // วันสุดท้ายของทุกเดือน
+	*/
+}
+
+// Between คืนวันเวลาที่เกิดซ้ำทั้งหมดในช่วง from ถึง to (รวมทั้งสองด้าน) เรียงตามเวลา
+// ถ้ากำหนด Adjust วันที่จะถูกเลื่อนก่อนตรวจช่วง และวันที่ซ้ำกันหลังเลื่อนจะเหลือเพียงครั้งเดียว
+func (r RRule) Between(from, to time.Time) []time.Time {
+	loc := loadLocation()
+	start := r.Start.In(loc)
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	// วันที่หลัง to อาจถูกเลื่อนย้อนกลับเข้ามาในช่วง
+	limit := to
+	if r.Adjust != AdjustNone {
+		limit = to.AddDate(0, 0, 14)
+	}
+
+	var result []time.Time
+	seen := make(map[time.Time]bool)
+	count := 0
+	for n := 0; ; n += interval {
+		period := r.periodStart(CivilDateOf(start), n)
+		if period.In(loc).After(limit) || (!r.Until.IsZero() && period.In(loc).After(r.Until)) {
+			break
+		}
+		for _, d := range r.applySetPos(r.expand(period, CivilDateOf(start))) {
+			t := time.Date(d.Year, d.Month, d.Day, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), loc)
+			if t.Before(start) {
+				continue
+			}
+			if (!r.Until.IsZero() && t.After(r.Until)) || t.After(limit) {
+				return sortTimes(result)
+			}
+			count++
+			if r.Count > 0 && count > r.Count {
+				return sortTimes(result)
+			}
+			t = r.adjust(t)
+			if !t.Before(from) && !t.After(to) && !seen[t] {
+				seen[t] = true
+				result = append(result, t)
+			}
+		}
+	}
+	return sortTimes(result)
+}
+
+// วันแรกของรอบที่ n นับจากรอบที่มี start
+func (r RRule) periodStart(start CivilDate, n int) CivilDate {
+	switch r.Freq {
+	case FreqWeekly:
+		offset := (int(start.Weekday()) - int(r.WeekStart) + 7) % 7
+		return start.AddDays(n*7 - offset)
+	case FreqMonthly:
+		return NewCivilDate(start.Year, start.Month+time.Month(n), 1)
+	case FreqYearly:
+		return NewCivilDate(start.Year+n, time.January, 1)
+	}
+	return start.AddDays(n)
+}
+
+// วันที่ทั้งหมดในรอบที่เริ่มวันที่ period เรียงจากน้อยไปมาก
+func (r RRule) expand(period, start CivilDate) []CivilDate {
+	var days []CivilDate
+	switch r.Freq {
+	case FreqDaily:
+		if r.matchMonth(period.Month) && r.matchMonthDay(period) && r.matchWeekday(period) {
+			days = append(days, period)
+		}
+	case FreqWeekly:
+		weekdays := []time.Weekday{start.Weekday()}
+		if len(r.ByDay) > 0 {
+			weekdays = weekdays[:0]
+			for _, wd := range r.ByDay {
+				weekdays = append(weekdays, wd.Weekday)
+			}
+		}
+		for _, wd := range weekdays {
+			d := period.AddDays((int(wd) - int(r.WeekStart) + 7) % 7)
+			if r.matchMonth(d.Month) {
+				days = append(days, d)
+			}
+		}
+	case FreqMonthly:
+		if r.matchMonth(period.Month) {
+			days = r.expandMonth(period.Year, period.Month, start)
+		}
+	case FreqYearly:
+		switch {
+		case len(r.ByMonth) > 0:
+			for _, m := range r.ByMonth {
+				days = append(days, r.expandMonth(period.Year, m, start)...)
+			}
+		case len(r.ByDay) > 0 && len(r.ByMonthDay) == 0:
+			days = nthWeekdays(period, NewCivilDate(period.Year, time.December, 31), r.ByDay)
+		default:
+			days = r.expandMonth(period.Year, start.Month, start)
+		}
+	}
+
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	unique := days[:0]
+	for i, d := range days {
+		if i == 0 || d != days[i-1] {
+			unique = append(unique, d)
+		}
+	}
+	return unique
+}
+
+// วันที่ในเดือนตาม BYMONTHDAY และ BYDAY (ถ้ามีทั้งคู่ใช้วันที่ตรงทั้งสองเงื่อนไข) ถ้าไม่มีทั้งคู่ใช้วันที่เดียวกับ start
+func (r RRule) expandMonth(year int, month time.Month, start CivilDate) []CivilDate {
+	n := daysInMonth(year, month)
+	var days []CivilDate
+	switch {
+	case len(r.ByDay) > 0:
+		for _, d := range nthWeekdays(NewCivilDate(year, month, 1), NewCivilDate(year, month, n), r.ByDay) {
+			if r.matchMonthDay(d) {
+				days = append(days, d)
+			}
+		}
+	case len(r.ByMonthDay) > 0:
+		for _, md := range r.ByMonthDay {
+			if md < 0 {
+				md = n + md + 1
+			}
+			if md >= 1 && md <= n {
+				days = append(days, CivilDate{year, month, md})
+			}
+		}
+	case start.Day <= n:
+		days = append(days, CivilDate{year, month, start.Day})
+	}
+	return days
+}
+
+// วันที่ในช่วง from ถึง to ที่ตรงกับ BYDAY โดย N นับลำดับภายในช่วง
+func nthWeekdays(from, to CivilDate, byDay []WeekdayNum) []CivilDate {
+	var result []CivilDate
+	for _, wd := range byDay {
+		var matches []CivilDate
+		for d := from.AddDays((int(wd.Weekday) - int(from.Weekday()) + 7) % 7); !d.After(to); d = d.AddDays(7) {
+			matches = append(matches, d)
+		}
+		switch {
+		case wd.N == 0:
+			result = append(result, matches...)
+		case wd.N > 0 && wd.N <= len(matches):
+			result = append(result, matches[wd.N-1])
+		case wd.N < 0 && -wd.N <= len(matches):
+			result = append(result, matches[len(matches)+wd.N])
+		}
+	}
+	return result
+}
+
+func (r RRule) applySetPos(days []CivilDate) []CivilDate {
+	if len(r.BySetPos) == 0 {
+		return days
+	}
+	var result []CivilDate
+	for _, pos := range r.BySetPos {
+		switch {
+		case pos > 0 && pos <= len(days):
+			result = append(result, days[pos-1])
+		case pos < 0 && -pos <= len(days):
+			result = append(result, days[len(days)+pos])
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+	return result
+}
+
+func (r RRule) matchMonth(m time.Month) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, bm := range r.ByMonth {
+		if bm == m {
+			return true
+		}
+	}
+	return false
+}
+
+func (r RRule) matchMonthDay(d CivilDate) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	n := daysInMonth(d.Year, d.Month)
+	for _, md := range r.ByMonthDay {
+		if md == d.Day || md == d.Day-n-1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (r RRule) matchWeekday(d CivilDate) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, wd := range r.ByDay {
+		if wd.Weekday == d.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// เลื่อนวันที่ไม่ใช่วันทำการตาม Adjust โดยคงเวลาของวันไว้
+func (r RRule) adjust(t time.Time) time.Time {
+	if r.Adjust == AdjustNone || IsBusinessDay(t, r.Calendar) {
+		return t
+	}
+	if r.Adjust == AdjustPrevious {
+		return AddBusinessDays(t, -1, r.Calendar)
+	}
+	return AddBusinessDays(t, 1, r.Calendar)
+}
+
+func parseRRuleUntil(value string) (time.Time, error) {
+	loc := loadLocation()
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("20060102", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return EndOfDay(t), nil
+}
+
+func parseRRuleInts[T any](value string, lo, hi int, convert func(int) T) ([]T, error) {
+	var result []T
+	for _, s := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < lo || n > hi || n == 0 {
+			return nil, fmt.Errorf("invalid value %q", s)
+		}
+		result = append(result, convert(n))
+	}
+	return result, nil
+}
+
+func parseRRuleByDay(value string) ([]WeekdayNum, error) {
+	var result []WeekdayNum
+	for _, s := range strings.Split(strings.ToUpper(value), ",") {
+		if len(s) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", s)
+		}
+		wd, ok := rruleWeekdays[s[len(s)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %q", s)
+		}
+		var n int
+		if prefix := s[:len(s)-2]; prefix != "" {
+			var err error
+			if n, err = strconv.Atoi(prefix); err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("invalid weekday %q", s)
+			}
+		}
+		result = append(result, WeekdayNum{N: n, Weekday: wd})
+	}
+	return result, nil
+}
+
+func sortTimes(times []time.Time) []time.Time {
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	return times
+}
@@ -0,0 +1,106 @@
+package aider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRRuleBetween(t *testing.T) {
+	loc := loadLocation()
+	start := time.Date(2025, 1, 1, 9, 0, 0, 0, loc)
+	end := time.Date(2025, 12, 31, 23, 59, 59, 0, loc)
+	tests := []struct {
+		name   string
+		rule   string
+		adjust BusinessDayAdjustment
+		from   time.Time
+		to     time.Time
+		want   []string
+	}{
+		{
+			name: "วันจันทร์ที่สองของเดือน",
+			rule: "FREQ=MONTHLY;BYDAY=2MO;COUNT=3",
+			want: []string{"2025-01-13", "2025-02-10", "2025-03-10"},
+		},
+		{
+			name: "วันสุดท้ายของเดือน",
+			rule: "FREQ=MONTHLY;BYMONTHDAY=-1",
+			to:   time.Date(2025, 4, 30, 23, 0, 0, 0, loc),
+			want: []string{"2025-01-31", "2025-02-28", "2025-03-31", "2025-04-30"},
+		},
+		{
+			name:   "วันที่ 25 หรือวันทำการก่อนหน้า",
+			rule:   "FREQ=MONTHLY;BYMONTHDAY=25;UNTIL=20250630",
+			adjust: AdjustPrevious,
+			want:   []string{"2025-01-24", "2025-02-25", "2025-03-25", "2025-04-25", "2025-05-23", "2025-06-25"},
+		},
+		{
+			name:   "วันทำการถัดไปข้ามวันหยุด",
+			rule:   "FREQ=YEARLY;BYMONTH=4;BYMONTHDAY=13;COUNT=1",
+			adjust: AdjustNext,
+			want:   []string{"2025-04-17"},
+		},
+		{
+			name: "ทุกสองสัปดาห์ จันทร์และศุกร์",
+			rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+			to:   time.Date(2025, 1, 31, 23, 0, 0, 0, loc),
+			want: []string{"2025-01-03", "2025-01-13", "2025-01-17", "2025-01-27", "2025-01-31"},
+		},
+		{
+			name: "วันทำการสุดท้ายของเดือน",
+			rule: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=2",
+			want: []string{"2025-01-31", "2025-02-28"},
+		},
+		{
+			name: "31 ข้ามเดือนที่ไม่มีวันที่ 31",
+			rule: "FREQ=MONTHLY;BYMONTHDAY=31;COUNT=3",
+			want: []string{"2025-01-31", "2025-03-31", "2025-05-31"},
+		},
+		{
+			name: "กรองตามช่วงแต่ยังนับ COUNT จากวันเริ่มต้น",
+			rule: "FREQ=DAILY;COUNT=10",
+			from: time.Date(2025, 1, 8, 0, 0, 0, 0, loc),
+			want: []string{"2025-01-08", "2025-01-09", "2025-01-10"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRRule(tt.rule, start)
+			if err != nil {
+				t.Fatalf("ParseRRule() error = %v", err)
+			}
+			r.Adjust, r.Calendar = tt.adjust, NewThaiHolidayCalendar()
+			from, to := tt.from, tt.to
+			if from.IsZero() {
+				from = start
+			}
+			if to.IsZero() {
+				to = end
+			}
+			got := r.Between(from, to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Between() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].Format(dateLayout) != tt.want[i] || got[i].Hour() != 9 {
+					t.Errorf("Between()[%d] = %v, want %s 09:00", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseRRuleErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"FREQ=HOURLY",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;COUNT=2;UNTIL=20250101",
+		"FREQ=DAILY;BYHOUR=9",
+	} {
+		if _, err := ParseRRule(s, time.Now()); err == nil {
+			t.Errorf("ParseRRule(%q) expected error", s)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+//go:build aider_tzdata
+
+package aider
+
+// ฝังฐานข้อมูลโซนเวลาไว้ในไบนารี สำหรับ container ที่ไม่มี /usr/share/zoneinfo
+// เปิดใช้ด้วย go build -tags aider_tzdata
+import _ "time/tzdata"
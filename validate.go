@@ -0,0 +1,300 @@
+package aider
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// FieldError is a single field failure reported by Validate.
+// Field is the JSON path of the field (e.g. "items[0].name") and Code is the rule that failed.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+func init() {
+	for id, m := range map[string][2]string{
+		"VALIDATION_REQUIRED":   {"กรุณาระบุ {field}", "{field} is required"},
+		"VALIDATION_MIN_LENGTH": {"{field} ต้องมีความยาวอย่างน้อย {param} ตัวอักษร", "{field} must be at least {param} characters long"},
+		"VALIDATION_MAX_LENGTH": {"{field} ต้องมีความยาวไม่เกิน {param} ตัวอักษร", "{field} must be at most {param} characters long"},
+		"VALIDATION_LENGTH":     {"{field} ต้องมีความยาว {param} ตัวอักษร", "{field} must be exactly {param} characters long"},
+		"VALIDATION_MIN":        {"{field} ต้องมีค่าอย่างน้อย {param}", "{field} must be at least {param}"},
+		"VALIDATION_MAX":        {"{field} ต้องมีค่าไม่เกิน {param}", "{field} must be at most {param}"},
+		"VALIDATION_MIN_ITEMS":  {"{field} ต้องมีอย่างน้อย {param} รายการ", "{field} must contain at least {param} items"},
+		"VALIDATION_MAX_ITEMS":  {"{field} ต้องมีไม่เกิน {param} รายการ", "{field} must contain at most {param} items"},
+		"VALIDATION_ITEMS":      {"{field} ต้องมี {param} รายการ", "{field} must contain exactly {param} items"},
+		"VALIDATION_EMAIL":      {"{field} ต้องเป็นอีเมลที่ถูกต้อง", "{field} must be a valid email address"},
+		"VALIDATION_ONEOF":      {"{field} ต้องเป็นค่าใดค่าหนึ่งใน {param}", "{field} must be one of {param}"},
+	} {
+		DefaultMessageCatalog.Set(id, map[string]string{languageTh: m[0], "en": m[1]})
+	}
+}
+
+// Validate checks the struct (or pointer to struct) v against its `validate` tags and
+// walks nested structs, pointers and slices of structs.
+// Field failures are returned together as a CustomError with code CodeValidation whose
+// Details["errors"] holds the []FieldError, with messages in language
+// (a language code or an Accept-Language header value, see MessageCatalog.MatchLanguage).
+// Invalid tags or a non-struct v return a plain error instead.
+//
+// Supported rules (comma separated):
+//
+//	required    the field must not be the zero value (nil pointer, "", 0, empty slice)
+//	omitempty   skip the other rules when the field is the zero value
+//	min=N max=N string length in characters, number value, or number of slice/map items
+//	len=N       exact string length or number of items
+//	email       a plain email address such as name@example.com
+//	oneof=a b   the value must be one of the space separated options
+//
+// `validate:"-"` skips the field and its nested fields.
+func Validate(v any, language string) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return errors.New("validate: nil pointer")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("validate: expected struct, got %s", rv.Kind())
+	}
+
+	vd := &validator{language: language}
+	if err := vd.walkStruct(rv, ""); err != nil {
+		return err
+	}
+	if len(vd.errs) == 0 {
+		return nil
+	}
+	msg, _ := DefaultMessageCatalog.Message(ErrorCode(CodeValidation).String(), language, nil)
+	return &CustomError{
+		Code:      CodeValidation,
+		Message:   msg,
+		MessageID: ErrorCode(CodeValidation).String(),
+		Details:   map[string]any{"errors": vd.errs},
+		stack:     callers(),
+	}
+
+	/*
+		Ex.
+		type CreateUserRequest struct {
+			Name  string   `json:"name" validate:"required,min=1,max=50"`
+			Email string   `json:"email" validate:"required,email"`
+			Role  string   `json:"role" validate:"oneof=admin user"`
+			Tags  []string `json:"tags" validate:"max=5"`
+		}
+		err := Validate(CreateUserRequest{Email: "abc", Role: "guest"}, r.Header.Get("Accept-Language"))
+		WriteProblem(w, r, err)
+		// 422 {"detail":"ข้อมูลไม่ผ่านการตรวจสอบ","errors":[
+		//   {"field":"name","code":"required","message":"กรุณาระบุ name"},
+		//   {"field":"email","code":"email","message":"email ต้องเป็นอีเมลที่ถูกต้อง"},
+		//   {"field":"role","code":"oneof","param":"admin user","message":"role ต้องเป็นค่าใดค่าหนึ่งใน admin, user"}], ...}
+	*/
+}
+
+// ValidationErrors returns the field errors carried by an error from Validate, or nil.
+func ValidationErrors(err error) []FieldError {
+	var e *CustomError
+	if !errors.As(err, &e) {
+		return nil
+	}
+	fields, _ := e.Details["errors"].([]FieldError)
+	return fields
+}
+
+type validator struct {
+	language string
+	errs     []FieldError
+}
+
+func (vd *validator) walkStruct(rv reflect.Value, prefix string) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		// unexported embedded structs still promote their exported fields
+		if !sf.IsExported() && !sf.Anonymous {
+			continue
+		}
+		tag := sf.Tag.Get("validate")
+		if tag == "-" {
+			continue
+		}
+		path := validationFieldName(sf)
+		switch {
+		case sf.Anonymous && sf.Tag.Get("json") == "":
+			// fields of an embedded struct are reported as if they were declared here
+			path = prefix
+		case prefix != "":
+			path = prefix + "." + path
+		}
+		if err := vd.checkField(rv.Field(i), path, tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walk descends into nested structs, pointers and slices of structs.
+func (vd *validator) walk(fv reflect.Value, path string) error {
+	for fv.Kind() == reflect.Pointer || fv.Kind() == reflect.Interface {
+		if fv.IsNil() {
+			return nil
+		}
+		fv = fv.Elem()
+	}
+	switch fv.Kind() {
+	case reflect.Struct:
+		return vd.walkStruct(fv, path)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < fv.Len(); i++ {
+			if err := vd.walk(fv.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (vd *validator) checkField(fv reflect.Value, path, tag string) error {
+	var rules []string
+	if tag != "" {
+		rules = strings.Split(tag, ",")
+	}
+	zero := fv.IsZero()
+	for _, rule := range rules {
+		if strings.TrimSpace(rule) == "omitempty" && zero {
+			return nil
+		}
+	}
+
+	value := fv
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			break
+		}
+		value = value.Elem()
+	}
+
+	for _, rule := range rules {
+		name, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		switch name {
+		case "", "omitempty":
+			continue
+		case "required":
+			if zero {
+				vd.add(path, name, "", "VALIDATION_REQUIRED", "")
+				return nil
+			}
+			continue
+		}
+		if value.Kind() == reflect.Pointer {
+			// an optional nil pointer has nothing else to check
+			return nil
+		}
+		if err := vd.checkRule(value, path, name, param); err != nil {
+			return err
+		}
+	}
+	return vd.walk(fv, path)
+}
+
+func (vd *validator) checkRule(fv reflect.Value, path, name, param string) error {
+	switch name {
+	case "min", "max", "len":
+		limit, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return fmt.Errorf("validate: invalid %s parameter %q on %s", name, param, path)
+		}
+		var n float64
+		var suffix string
+		switch fv.Kind() {
+		case reflect.String:
+			n, suffix = float64(utf8.RuneCountInString(fv.String())), "LENGTH"
+		case reflect.Slice, reflect.Array, reflect.Map:
+			n, suffix = float64(fv.Len()), "ITEMS"
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n = float64(fv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			n = float64(fv.Uint())
+		case reflect.Float32, reflect.Float64:
+			n = fv.Float()
+		default:
+			return fmt.Errorf("validate: rule %s not supported on %s (%s)", name, path, fv.Kind())
+		}
+		switch {
+		case name == "min" && n < limit:
+			vd.add(path, name, param, validationMessageID("MIN", suffix), param)
+		case name == "max" && n > limit:
+			vd.add(path, name, param, validationMessageID("MAX", suffix), param)
+		case name == "len" && suffix == "":
+			return fmt.Errorf("validate: rule len not supported on %s (%s)", path, fv.Kind())
+		case name == "len" && n != limit:
+			vd.add(path, name, param, "VALIDATION_"+suffix, param)
+		}
+	case "email":
+		if fv.Kind() != reflect.String {
+			return fmt.Errorf("validate: rule email not supported on %s (%s)", path, fv.Kind())
+		}
+		if addr, err := mail.ParseAddress(fv.String()); err != nil || addr.Address != fv.String() {
+			vd.add(path, name, "", "VALIDATION_EMAIL", "")
+		}
+	case "oneof":
+		options := strings.Fields(param)
+		if len(options) == 0 {
+			return fmt.Errorf("validate: rule oneof without options on %s", path)
+		}
+		var s string
+		switch fv.Kind() {
+		case reflect.String:
+			s = fv.String()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			s = strconv.FormatInt(fv.Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			s = strconv.FormatUint(fv.Uint(), 10)
+		case reflect.Float32, reflect.Float64:
+			s = strconv.FormatFloat(fv.Float(), 'f', -1, 64)
+		case reflect.Bool:
+			s = strconv.FormatBool(fv.Bool())
+		default:
+			return fmt.Errorf("validate: rule oneof not supported on %s (%s)", path, fv.Kind())
+		}
+		for _, option := range options {
+			if s == option {
+				return nil
+			}
+		}
+		vd.add(path, name, param, "VALIDATION_ONEOF", strings.Join(options, ", "))
+	default:
+		return fmt.Errorf("validate: unknown rule %q on %s", name, path)
+	}
+	return nil
+}
+
+func (vd *validator) add(path, code, param, messageID, messageParam string) {
+	msg, _ := DefaultMessageCatalog.Message(messageID, vd.language, map[string]any{"field": path, "param": messageParam})
+	vd.errs = append(vd.errs, FieldError{Field: path, Code: code, Param: param, Message: msg})
+}
+
+// validationMessageID returns the catalog id of a min/max failure, e.g. VALIDATION_MIN_LENGTH, or VALIDATION_MIN for numbers.
+func validationMessageID(rule, suffix string) string {
+	if suffix == "" {
+		return "VALIDATION_" + rule
+	}
+	return "VALIDATION_" + rule + "_" + suffix
+}
+
+// validationFieldName returns the json name of the field, or the Go name when it has none.
+func validationFieldName(sf reflect.StructField) string {
+	name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return sf.Name
+	}
+	return name
+}
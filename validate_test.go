@@ -0,0 +1,194 @@
+package aider
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type validateAddress struct {
+	Zip string `json:"zip" validate:"required,len=5"`
+}
+
+type validateItem struct {
+	SKU string `json:"sku" validate:"required"`
+	Qty int    `json:"qty" validate:"min=1,max=99"`
+}
+
+type validateBase struct {
+	ID string `json:"id" validate:"required"`
+}
+
+type validateOrder struct {
+	validateBase
+	Name     string           `json:"name" validate:"required,min=2,max=10"`
+	Email    string           `json:"email" validate:"omitempty,email"`
+	Status   string           `json:"status" validate:"oneof=new paid"`
+	Address  validateAddress  `json:"address"`
+	Billing  *validateAddress `json:"billing"`
+	Items    []validateItem   `json:"items" validate:"required,max=3"`
+	Discount *float64         `json:"discount" validate:"min=0,max=100"`
+	Internal validateAddress  `validate:"-"`
+}
+
+func validOrder() validateOrder {
+	return validateOrder{
+		validateBase: validateBase{ID: "A1"},
+		Name:         "สมชาย",
+		Status:       "new",
+		Address:      validateAddress{Zip: "10110"},
+		Items:        []validateItem{{SKU: "X", Qty: 1}},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	negative := -5.0
+	tests := []struct {
+		name   string
+		modify func(o *validateOrder)
+		want   []FieldError
+	}{
+		{name: "ข้อมูลถูกต้อง", modify: func(o *validateOrder) {}},
+		{
+			name:   "ไม่ระบุฟิลด์ที่จำเป็น",
+			modify: func(o *validateOrder) { o.ID, o.Name, o.Items = "", "", nil },
+			want: []FieldError{
+				{Field: "id", Code: "required", Message: "กรุณาระบุ id"},
+				{Field: "name", Code: "required", Message: "กรุณาระบุ name"},
+				{Field: "items", Code: "required", Message: "กรุณาระบุ items"},
+			},
+		},
+		{
+			name:   "ความยาวข้อความนับเป็นตัวอักษร",
+			modify: func(o *validateOrder) { o.Name = "สมชายใจดีมากมาก" },
+			want:   []FieldError{{Field: "name", Code: "max", Param: "10", Message: "name ต้องมีความยาวไม่เกิน 10 ตัวอักษร"}},
+		},
+		{
+			name:   "อีเมลและ oneof",
+			modify: func(o *validateOrder) { o.Email, o.Status = "not-an-email", "void" },
+			want: []FieldError{
+				{Field: "email", Code: "email", Message: "email ต้องเป็นอีเมลที่ถูกต้อง"},
+				{Field: "status", Code: "oneof", Param: "new paid", Message: "status ต้องเป็นค่าใดค่าหนึ่งใน new, paid"},
+			},
+		},
+		{
+			name: "struct ซ้อนและ slice",
+			modify: func(o *validateOrder) {
+				o.Address.Zip = "101"
+				o.Billing = &validateAddress{}
+				o.Items = []validateItem{{SKU: "X", Qty: 1}, {Qty: 100}}
+			},
+			want: []FieldError{
+				{Field: "address.zip", Code: "len", Param: "5", Message: "address.zip ต้องมีความยาว 5 ตัวอักษร"},
+				{Field: "billing.zip", Code: "required", Message: "กรุณาระบุ billing.zip"},
+				{Field: "items[1].sku", Code: "required", Message: "กรุณาระบุ items[1].sku"},
+				{Field: "items[1].qty", Code: "max", Param: "99", Message: "items[1].qty ต้องมีค่าไม่เกิน 99"},
+			},
+		},
+		{
+			name: "จำนวนรายการและ pointer",
+			modify: func(o *validateOrder) {
+				o.Items = make([]validateItem, 4)
+				for i := range o.Items {
+					o.Items[i] = validateItem{SKU: "X", Qty: 1}
+				}
+				o.Discount = &negative
+			},
+			want: []FieldError{
+				{Field: "items", Code: "max", Param: "3", Message: "items ต้องมีไม่เกิน 3 รายการ"},
+				{Field: "discount", Code: "min", Param: "0", Message: "discount ต้องมีค่าอย่างน้อย 0"},
+			},
+		},
+		{
+			name:   "ข้ามฟิลด์ที่มี validate:\"-\"",
+			modify: func(o *validateOrder) { o.Internal.Zip = "1" },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := validOrder()
+			tt.modify(&o)
+			err := Validate(&o, "th")
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if CodeOf(err) != CodeValidation {
+				t.Fatalf("Validate() code = %v, want VALIDATION", CodeOf(err))
+			}
+			if got := ValidationErrors(err); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidationErrors() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateLanguage(t *testing.T) {
+	o := validOrder()
+	o.Name = ""
+	err := Validate(o, "en-US,en;q=0.9")
+	if got := err.(*CustomError).Message; got != "validation failed" {
+		t.Errorf("Message = %q, want %q", got, "validation failed")
+	}
+	if got := ValidationErrors(err)[0].Message; got != "name is required" {
+		t.Errorf("field message = %q, want %q", got, "name is required")
+	}
+}
+
+func TestValidateInvalidUsage(t *testing.T) {
+	var nilOrder *validateOrder
+	tests := []struct {
+		name string
+		v    any
+	}{
+		{name: "ไม่ใช่ struct", v: "text"},
+		{name: "nil pointer", v: nilOrder},
+		{name: "กฎที่ไม่รู้จัก", v: struct {
+			A string `validate:"uuid"`
+		}{}},
+		{name: "พารามิเตอร์ผิด", v: struct {
+			A string `validate:"min=abc"`
+		}{}},
+		{name: "len กับตัวเลข", v: struct {
+			A int `validate:"len=3"`
+		}{A: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.v, "th")
+			if err == nil {
+				t.Fatal("Validate() error = nil, want error")
+			}
+			var ce *CustomError
+			if errors.As(err, &ce) {
+				t.Errorf("Validate() = %v, want a plain error for invalid usage", err)
+			}
+		})
+	}
+}
+
+func TestValidateProblemResponse(t *testing.T) {
+	o := validOrder()
+	o.Status = "void"
+	rec := httptest.NewRecorder()
+	WriteProblem(rec, httptest.NewRequest(http.MethodPost, "/orders", nil), Validate(o, "en"))
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want 422", rec.Code)
+	}
+	var body struct {
+		Detail string       `json:"detail"`
+		Errors []FieldError `json:"errors"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Detail != "validation failed" || len(body.Errors) != 1 || !strings.HasPrefix(body.Errors[0].Message, "status must be one of") {
+		t.Errorf("body = %+v", body)
+	}
+}